@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,13 +35,14 @@ const (
 )
 
 type Client struct {
-	Exec             string
-	UserAgentAppend  string
-	ApplicationKeyId string
-	ApplicationKey   string
-	Endpoint         string
-	DataSourcesMap   map[string]*schema.Resource
-	ResourcesMap     map[string]*schema.Resource
+	Exec                 string
+	UserAgentAppend      string
+	ApplicationKeyId     string
+	ApplicationKey       string
+	Endpoint             string
+	KeyExpiryWarningDays int
+	DataSourcesMap       map[string]*schema.Resource
+	ResourcesMap         map[string]*schema.Resource
 }
 
 // Apply executes a provider operation with typed input and output.
@@ -55,8 +57,10 @@ func (c Client) Apply(ctx context.Context, op Operation, input ResourceSchema, o
 	// Convert input struct to map for backward compatibility with Python bindings
 	inputMap := convertStructToMap(input)
 
-	tflog.Debug(ctx, "Input for pybindings", map[string]interface{}{
-		"input": inputMap,
+	schemaMap := c.getSchemaMap(name, op)
+
+	tflog.Debug(ctx, "Safe input for pybindings", map[string]interface{}{
+		"input": sanitizeInput(inputMap, schemaMap),
 	})
 
 	cmd := exec.Command(c.Exec, name, string(op))
@@ -100,7 +104,6 @@ func (c Client) Apply(ctx context.Context, op Operation, input ResourceSchema, o
 			return err
 		}
 
-		schemaMap := c.getSchemaMap(name, op)
 		if schemaMap == nil {
 			// Should never happen
 			return fmt.Errorf("schema not found for resource: b2_%s", name)
@@ -173,3 +176,45 @@ func sanitizeOutput(output interface{}, schemaMap map[string]*schema.Schema) map
 
 	return safeOutput
 }
+
+// sanitizeInput masks the sensitive fields of the input, including the nested
+// ones, so that the input can be logged.
+func sanitizeInput(input map[string]interface{}, schemaMap map[string]*schema.Schema) map[string]interface{} {
+	safeInput := map[string]interface{}{}
+
+	for k, v := range input {
+		// The previous values of a field, e.g. previous_notification_rules, are as sensitive as the field
+		s, ok := schemaMap[k]
+		if !ok {
+			s, ok = schemaMap[strings.TrimPrefix(k, "previous_")]
+		}
+		switch {
+		case !ok:
+			safeInput[k] = v
+		case s.Sensitive:
+			safeInput[k] = "***"
+		default:
+			safeInput[k] = sanitizeNestedInput(v, s)
+		}
+	}
+
+	return safeInput
+}
+
+func sanitizeNestedInput(v interface{}, s *schema.Schema) interface{} {
+	elem, ok := s.Elem.(*schema.Resource)
+	items, isList := v.([]interface{})
+	if !ok || !isList {
+		return v
+	}
+
+	safeItems := make([]interface{}, len(items))
+	for i, item := range items {
+		if itemMap, ok := item.(map[string]interface{}); ok {
+			safeItems[i] = sanitizeInput(itemMap, elem.Schema)
+		} else {
+			safeItems[i] = item
+		}
+	}
+	return safeItems
+}
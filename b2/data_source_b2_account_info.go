@@ -61,6 +61,17 @@ func dataSourceB2AccountInfo() *schema.Resource {
 				Type:        schema.TypeInt,
 				Computed:    true,
 			},
+			"application_key_id": {
+				Description: "The ID of the application key used by the provider.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"application_key_expiration_timestamp": {
+				Description: "When present, says when the application key used by the provider will expire, in milliseconds since 1970." +
+					" It is only known if the key has the `listKeys` capability.",
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"absolute_minimum_part_size": {
 				Description: "The smallest possible size of a part of a large file (except the last one). This is smaller than the recommendedPartSize. If you use it, you may find that it takes longer overall to upload a large file.",
 				Type:        schema.TypeInt,
@@ -92,7 +103,7 @@ func dataSourceB2AccountInfoRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return keyExpiryWarnings(client.KeyExpiryWarningDays, output.ApplicationKeyId, output.ApplicationKeyExpirationTimestamp)
 }
 
 func dataSourceB2AccountInfoPopulateDeprecated(d *schema.ResourceData) error {
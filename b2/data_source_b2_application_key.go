@@ -26,14 +26,30 @@ func dataSourceB2ApplicationKey() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"key_name": {
-				Description:  "The name assigned when the key was created.",
+				Description:  "The name assigned when the key was created. Several keys can have the same name, then the key has to be looked up by ID.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"key_name", "application_key_id"},
 			},
 			"application_key_id": {
-				Description: "The ID of the key.",
-				Type:        schema.TypeString,
+				Description:  "The ID of the key.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"key_name", "application_key_id"},
+			},
+			"fail_if_not_found": {
+				Description: "Whether to fail when the application key is not found. When false, `exists` is false and the other attributes are empty instead.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"exists": {
+				Description: "Whether the application key has been found.",
+				Type:        schema.TypeBool,
 				Computed:    true,
 			},
 			"bucket_ids": {
@@ -62,6 +78,11 @@ func dataSourceB2ApplicationKey() *schema.Resource {
 				Type:        schema.TypeInt,
 				Computed:    true,
 			},
+			"expiration_time": {
+				Description: "When present, says when this key will expire, as an RFC 3339 timestamp.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"options": {
 				Description: "A list of application key options.",
 				Type:        schema.TypeSet,
@@ -84,7 +105,9 @@ func dataSourceB2ApplicationKeyRead(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*Client)
 
 	input := ApplicationKeyInput{
-		KeyName: d.Get("key_name").(string),
+		KeyName:          d.Get("key_name").(string),
+		ApplicationKeyId: d.Get("application_key_id").(string),
+		FailIfNotFound:   d.Get("fail_if_not_found").(bool),
 	}
 
 	var output ApplicationKeyOutput
@@ -93,7 +116,18 @@ func dataSourceB2ApplicationKeyRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	d.SetId(output.ApplicationKeyId)
+	// These fields are not returned by the API but are needed for the data source
+	output.ExpirationTime = formatTimestamp(output.ExpirationTimestamp)
+	output.Exists = output.ApplicationKeyId != ""
+	output.FailIfNotFound = input.FailIfNotFound
+	if !output.Exists {
+		// Keep the key that has been looked up
+		output.KeyName = input.KeyName
+		output.ApplicationKeyId = input.ApplicationKeyId
+		d.SetId(input.KeyName + input.ApplicationKeyId)
+	} else {
+		d.SetId(output.ApplicationKeyId)
+	}
 
 	err = client.Populate(ctx, OpDataSourceRead, &output, d)
 	if err != nil {
@@ -12,6 +12,7 @@ package b2
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -39,6 +40,7 @@ func TestAccDataSourceB2ApplicationKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "capabilities", resourceName, "capabilities"),
 					resource.TestCheckResourceAttr(dataSourceName, "key_name", keyName),
 					resource.TestCheckResourceAttrPair(dataSourceName, "key_name", resourceName, "key_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "true"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "name_prefix", resourceName, "name_prefix"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "expiration_timestamp", resourceName, "expiration_timestamp"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "options", resourceName, "options"),
@@ -109,6 +111,69 @@ func TestAccDataSourceB2ApplicationKey_deprecatedBucketId(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceB2ApplicationKey_byId(t *testing.T) {
+	resourceName := "b2_application_key.test"
+	dataSourceName := "data.b2_application_key.test"
+
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2ApplicationKeyConfig_byId(keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "application_key_id", resourceName, "application_key_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "key_name", keyName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "capabilities", resourceName, "capabilities"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2ApplicationKey_duplicateName(t *testing.T) {
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceB2ApplicationKeyConfig_duplicateName(keyName),
+				ExpectError: regexp.MustCompile("Found 2 Application Keys for"),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2ApplicationKey_notFound(t *testing.T) {
+	dataSourceName := "data.b2_application_key.test"
+
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceB2ApplicationKeyConfig_notFound(keyName, "true"),
+				ExpectError: regexp.MustCompile("Could not find Application Key"),
+			},
+			{
+				Config: testAccDataSourceB2ApplicationKeyConfig_notFound(keyName, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "key_name", keyName),
+					resource.TestCheckResourceAttr(dataSourceName, "application_key_id", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "capabilities.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceB2ApplicationKeyConfig_basic(keyName string) string {
 	return fmt.Sprintf(`
 resource "b2_application_key" "test" {
@@ -173,3 +238,44 @@ data "b2_application_key" "test" {
 }
 `, keyName, keyName)
 }
+
+func testAccDataSourceB2ApplicationKeyConfig_byId(keyName string) string {
+	return fmt.Sprintf(`
+resource "b2_application_key" "test" {
+  key_name = "%s"
+  capabilities = ["readFiles"]
+}
+
+data "b2_application_key" "test" {
+  application_key_id = b2_application_key.test.application_key_id
+}
+`, keyName)
+}
+
+func testAccDataSourceB2ApplicationKeyConfig_duplicateName(keyName string) string {
+	return fmt.Sprintf(`
+resource "b2_application_key" "test" {
+  count = 2
+
+  key_name = "%s"
+  capabilities = ["readFiles"]
+}
+
+data "b2_application_key" "test" {
+  key_name = b2_application_key.test[1].key_name
+
+  depends_on = [
+    b2_application_key.test,
+  ]
+}
+`, keyName)
+}
+
+func testAccDataSourceB2ApplicationKeyConfig_notFound(keyName string, failIfNotFound string) string {
+	return fmt.Sprintf(`
+data "b2_application_key" "test" {
+  key_name          = "%s"
+  fail_if_not_found = %s
+}
+`, keyName, failIfNotFound)
+}
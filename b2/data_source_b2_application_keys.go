@@ -0,0 +1,211 @@
+//####################################################################
+//
+// File: b2/data_source_b2_application_keys.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceB2ApplicationKeys() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 application keys data source. Lists the application keys of the account, without their secrets." +
+			" It requires the `listKeys` capability.",
+
+		ReadContext: dataSourceB2ApplicationKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Description: "When provided, only the keys whose names start with the prefix are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name_regex": {
+				Description:  "When provided, only the keys whose names match the regular expression are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"capability": {
+				Description:  "When provided, only the keys having the capability are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(applicationKeyCapabilities, false),
+			},
+			"bucket_id": {
+				Description:  "When provided, only the keys restricted to the bucket are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"expiring_within": {
+				Description:  "When provided, only the keys that have expired or expire within the given duration, e.g. `168h`, are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+			},
+			"unrestricted": {
+				Description: "When provided, only the keys that are not restricted to buckets, or only the restricted ones, are returned.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"keys": {
+				Description: "The application keys, sorted by name.",
+				Type:        schema.TypeList,
+				Elem:        getDataSourceApplicationKeysElem(),
+				Computed:    true,
+			},
+			"application_key_ids": {
+				Description: "The IDs of the application keys.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getDataSourceApplicationKeysElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"application_key_id": {
+				Description: "The ID of the key.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"key_name": {
+				Description: "The name of the key.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"capabilities": {
+				Description: "A set of strings, each one naming a capability the key has.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"bucket_ids": {
+				Description: "When present, restricts access to specified buckets.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"name_prefix": {
+				Description: "When present, restricts access to files whose names start with the prefix.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"expiration_timestamp": {
+				Description: "When present, says when this key will expire, in milliseconds since 1970.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"expiration_time": {
+				Description: "When present, says when this key will expire, as an RFC 3339 timestamp.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"options": {
+				Description: "A list of application key options.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceB2ApplicationKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := ApplicationKeysInput{
+		NamePrefix:     d.Get("name_prefix").(string),
+		NameRegex:      d.Get("name_regex").(string),
+		Capability:     d.Get("capability").(string),
+		BucketId:       d.Get("bucket_id").(string),
+		ExpiringWithin: d.Get("expiring_within").(string),
+		Unrestricted:   d.Get("unrestricted").(bool),
+	}
+
+	var output ApplicationKeysOutput
+	err := client.Apply(ctx, OpDataSourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if input.NameRegex != "" {
+		nameRegex = regexp.MustCompile(input.NameRegex)
+	}
+	var expiringBefore time.Time
+	if input.ExpiringWithin != "" {
+		expiringWithin, _ := time.ParseDuration(input.ExpiringWithin)
+		expiringBefore = time.Now().Add(expiringWithin)
+	}
+	filterUnrestricted := !d.GetRawConfig().GetAttr("unrestricted").IsNull()
+
+	keys := []ApplicationKeysKey{}
+	applicationKeyIds := []string{}
+	for _, key := range output.Keys {
+		if !strings.HasPrefix(key.KeyName, input.NamePrefix) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(key.KeyName) {
+			continue
+		}
+		if input.Capability != "" && !slices.Contains(key.Capabilities, input.Capability) {
+			continue
+		}
+		if input.BucketId != "" && !slices.Contains(key.BucketIds, input.BucketId) {
+			continue
+		}
+		if !expiringBefore.IsZero() &&
+			(key.ExpirationTimestamp == 0 || !time.UnixMilli(int64(key.ExpirationTimestamp)).Before(expiringBefore)) {
+			continue
+		}
+		if filterUnrestricted && (len(key.BucketIds) == 0) != input.Unrestricted {
+			continue
+		}
+		// This field is not returned by the API but is needed for the data source
+		key.ExpirationTime = formatTimestamp(key.ExpirationTimestamp)
+		keys = append(keys, key)
+		applicationKeyIds = append(applicationKeyIds, key.ApplicationKeyId)
+	}
+
+	// These fields are not returned by the API but are needed for the data source
+	output.ApplicationKeysInput = input
+	output.Keys = keys
+	output.ApplicationKeyIds = applicationKeyIds
+
+	d.SetId(output.Sha1)
+
+	err = client.Populate(ctx, OpDataSourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+//####################################################################
+//
+// File: b2/data_source_b2_application_keys_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceB2ApplicationKeys_basic(t *testing.T) {
+	resourceName := "b2_application_key.test"
+	dataSourceName := "data.b2_application_keys.test"
+
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2ApplicationKeysConfig_basic(keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "keys.0.application_key_id", resourceName, "application_key_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0.key_name", keyName),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0.capabilities.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0.capabilities.0", "readFiles"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0.bucket_ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0.name_prefix", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "keys.0.expiration_timestamp", resourceName, "expiration_timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "keys.0.expiration_time", resourceName, "expiration_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "keys.0.options", resourceName, "options"),
+					resource.TestCheckResourceAttr(dataSourceName, "application_key_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "application_key_ids.0", resourceName, "application_key_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2ApplicationKeys_filters(t *testing.T) {
+	dataSourceName := "data.b2_application_keys.test"
+
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2ApplicationKeysConfig_filters(keyName, "readFiles", "48h", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0.key_name", keyName),
+				),
+			},
+			{
+				Config: testAccDataSourceB2ApplicationKeysConfig_filters(keyName, "writeFiles", "48h", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "0"),
+				),
+			},
+			{
+				Config: testAccDataSourceB2ApplicationKeysConfig_filters(keyName, "readFiles", "1h", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "0"),
+				),
+			},
+			{
+				Config: testAccDataSourceB2ApplicationKeysConfig_filters(keyName, "readFiles", "48h", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceB2ApplicationKeysConfig_basic(keyName string) string {
+	return fmt.Sprintf(`
+resource "b2_application_key" "test" {
+  key_name     = "%s"
+  capabilities = ["readFiles"]
+}
+
+data "b2_application_keys" "test" {
+  name_prefix = b2_application_key.test.key_name
+}
+`, keyName)
+}
+
+func testAccDataSourceB2ApplicationKeysConfig_filters(keyName string, capability string, expiringWithin string, unrestricted bool) string {
+	return fmt.Sprintf(`
+resource "b2_application_key" "test" {
+  key_name                  = "%s"
+  capabilities              = ["readFiles"]
+  valid_duration_in_seconds = 86400
+}
+
+data "b2_application_keys" "test" {
+  name_regex      = "^${b2_application_key.test.key_name}$"
+  capability      = "%s"
+  expiring_within = "%s"
+  unrestricted    = %t
+}
+`, keyName, capability, expiringWithin, unrestricted)
+}
@@ -28,8 +28,21 @@ func dataSourceB2Bucket() *schema.Resource {
 			"bucket_name": {
 				Description:  "The name of the bucket.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"bucket_name", "bucket_id"},
+			},
+			"fail_if_not_found": {
+				Description: "Whether to fail when the bucket is not found. When false, `exists` is false and the other attributes are empty instead.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"exists": {
+				Description: "Whether the bucket has been found.",
+				Type:        schema.TypeBool,
+				Computed:    true,
 			},
 			"account_id": {
 				Description: "Account ID that the bucket belongs to.",
@@ -37,9 +50,12 @@ func dataSourceB2Bucket() *schema.Resource {
 				Computed:    true,
 			},
 			"bucket_id": {
-				Description: "The ID of the bucket.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The ID of the bucket.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"bucket_name", "bucket_id"},
 			},
 			"bucket_info": {
 				Description: "User-defined information to be stored with the bucket.",
@@ -78,6 +94,12 @@ func dataSourceB2Bucket() *schema.Resource {
 				Elem:        getLifecycleRulesElem(true),
 				Computed:    true,
 			},
+			"replication_configuration": {
+				Description: "Cloud Replication settings of the bucket.",
+				Type:        schema.TypeList,
+				Elem:        getReplicationConfigurationElem(true),
+				Computed:    true,
+			},
 			"options": {
 				Description: "List of bucket options.",
 				Type:        schema.TypeSet,
@@ -99,7 +121,9 @@ func dataSourceB2BucketRead(ctx context.Context, d *schema.ResourceData, meta in
 	client := meta.(*Client)
 
 	input := BucketInput{
-		BucketName: d.Get("bucket_name").(string),
+		BucketName:     d.Get("bucket_name").(string),
+		BucketId:       d.Get("bucket_id").(string),
+		FailIfNotFound: d.Get("fail_if_not_found").(bool),
 	}
 
 	var output BucketOutput
@@ -108,7 +132,17 @@ func dataSourceB2BucketRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
-	d.SetId(output.BucketId)
+	// These fields are not returned by the API but are needed for the data source
+	output.Exists = output.BucketId != ""
+	output.FailIfNotFound = input.FailIfNotFound
+	if !output.Exists {
+		// Keep the bucket that has been looked up
+		output.BucketName = input.BucketName
+		output.BucketId = input.BucketId
+		d.SetId(input.BucketName + input.BucketId)
+	} else {
+		d.SetId(output.BucketId)
+	}
 
 	err = client.Populate(ctx, OpDataSourceRead, &output, d)
 	if err != nil {
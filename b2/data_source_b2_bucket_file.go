@@ -19,36 +19,76 @@ import (
 )
 
 func dataSourceB2BucketFile() *schema.Resource {
+	dataSourceSchema := map[string]*schema.Schema{
+		"bucket_id": {
+			Description:  "The ID of the bucket.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"file_name": {
+			Description:  "The file name.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"show_versions": {
+			Description: "Show all file versions.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"file_id": {
+			Description:   "When provided, selects the file version with the given ID.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"at_time"},
+		},
+		"at_time": {
+			Description: "When provided, selects the file version that was the latest one at the given time," +
+				" as an RFC 3339 timestamp or milliseconds since 1970.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  validateTimestamp,
+			ConflictsWith: []string{"file_id"},
+		},
+		"include_hidden": {
+			Description: "Whether a hidden file counts as present. When true, hide markers are skipped when selecting the file version," +
+				" and a hide marker selected by `file_id` is returned.",
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"fail_if_not_found": {
+			Description: "Whether to fail when the bucket is not found. A missing file never fails; `exists` is false then.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"exists": {
+			Description: "Whether a file version has been selected, and it is not a hide marker unless `include_hidden` is true.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"file_versions": {
+			Description: "File versions.",
+			Type:        schema.TypeList,
+			Elem:        getDataSourceFileVersionsElem(),
+			Computed:    true,
+		},
+	}
+	// The selected file version, which is the latest one by default, is exposed as top-level attributes
+	for k, v := range getDataSourceFileVersionsElem().Schema {
+		if _, ok := dataSourceSchema[k]; !ok {
+			dataSourceSchema[k] = v
+		}
+	}
+
 	return &schema.Resource{
 		Description: "B2 bucket file data source.",
 
 		ReadContext: dataSourceB2BucketFileRead,
 
-		Schema: map[string]*schema.Schema{
-			"bucket_id": {
-				Description:  "The ID of the bucket.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.NoZeroValues,
-			},
-			"file_name": {
-				Description:  "The file name.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.NoZeroValues,
-			},
-			"show_versions": {
-				Description: "Show all file versions.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-			},
-			"file_versions": {
-				Description: "File versions.",
-				Type:        schema.TypeList,
-				Elem:        getDataSourceFileVersionsElem(),
-				Computed:    true,
-			},
-		},
+		Schema: dataSourceSchema,
 	}
 }
 
@@ -56,9 +96,17 @@ func dataSourceB2BucketFileRead(ctx context.Context, d *schema.ResourceData, met
 	client := meta.(*Client)
 
 	input := BucketFileInput{
-		BucketId:     d.Get("bucket_id").(string),
-		FileName:     d.Get("file_name").(string),
-		ShowVersions: d.Get("show_versions").(bool),
+		BucketId:       d.Get("bucket_id").(string),
+		FileName:       d.Get("file_name").(string),
+		ShowVersions:   d.Get("show_versions").(bool),
+		FileId:         d.Get("file_id").(string),
+		AtTime:         d.Get("at_time").(string),
+		IncludeHidden:  d.Get("include_hidden").(bool),
+		FailIfNotFound: d.Get("fail_if_not_found").(bool),
+	}
+	if input.AtTime != "" {
+		atTimestamp, _ := parseTimestamp(input.AtTime)
+		input.AtTimestamp = int(atTimestamp)
 	}
 
 	var output BucketFileOutput
@@ -67,6 +115,35 @@ func dataSourceB2BucketFileRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
+	// These fields are not returned by the API but are needed for the data source
+	output.FailIfNotFound = input.FailIfNotFound
+	output.AtTime = input.AtTime
+	output.IncludeHidden = input.IncludeHidden
+	selected := output.SelectedVersion
+	output.Exists = selected != nil && (selected.Action != "hide" || input.IncludeHidden)
+	if output.Exists {
+		output.FileId = selected.FileId
+		output.Action = selected.Action
+		output.ContentMd5 = selected.ContentMd5
+		output.ContentSha1 = selected.ContentSha1
+		output.ContentType = selected.ContentType
+		output.FileInfo = selected.FileInfo
+		output.FileRetention = selected.FileRetention
+		output.FileRetentionReadable = selected.FileRetentionReadable
+		output.LegalHold = selected.LegalHold
+		output.LegalHoldReadable = selected.LegalHoldReadable
+		output.ServerSideEncryption = selected.ServerSideEncryption
+		output.Size = selected.Size
+		output.UploadTimestamp = selected.UploadTimestamp
+		output.ReplicationStatus = selected.ReplicationStatus
+		output.SseCKeyId = selected.SseCKeyId
+		output.SrcLastModifiedMillis = selected.SrcLastModifiedMillis
+		output.LargeFileSha1 = selected.LargeFileSha1
+	} else {
+		// Keep the version that has been looked up
+		output.FileId = input.FileId
+	}
+
 	d.SetId(output.Sha1)
 
 	err = client.Populate(ctx, OpDataSourceRead, &output, d)
@@ -0,0 +1,170 @@
+//####################################################################
+//
+// File: b2/data_source_b2_bucket_file_content.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+	"encoding/base64"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const defaultBucketFileContentMaxSize = 1024 * 1024
+
+func dataSourceB2BucketFileContent() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 bucket file content data source. Downloads the content of a file." +
+			" The SHA1 checksum of the content is verified when the whole file is downloaded.",
+
+		ReadContext: dataSourceB2BucketFileContentRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Description:  "The ID of the bucket.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"file_name": {
+				Description:  "The file name.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"file_id": {
+				Description: "The ID of the file version to download. The latest version of the file is downloaded by default.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"sse_c_key_b64": {
+				Description:  "The key the file is encrypted with in SSE-C mode, in standard Base 64 encoding (RFC 4648).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validateBase64Key,
+			},
+			"range_start": {
+				Description:  "The offset of the first byte to download.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"range_end"},
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"range_end": {
+				Description:  "The offset of the last byte to download, inclusive.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"range_start"},
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_size": {
+				Description:  "The maximum number of bytes to keep in the state. Downloading more fails, unless `output_path` is set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultBucketFileContentMaxSize,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"output_path": {
+				Description: "When provided, the content is written to the local file instead of being kept in the state.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"content": {
+				Description: "The content, when it is valid UTF-8 text.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"content_base64": {
+				Description: "The content, in standard Base 64 encoding (RFC 4648).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"content_length": {
+				Description: "The number of bytes downloaded.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"content_sha1": {
+				Description: "SHA1 hash of the whole file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"content_type": {
+				Description: "Content type of the file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"file_info": {
+				Description: "The custom information that is uploaded with the file.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"size": {
+				Description: "The file size.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"upload_timestamp": {
+				Description: "This is a UTC time when this file was uploaded.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceB2BucketFileContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileContentInput{
+		BucketId:   d.Get("bucket_id").(string),
+		FileName:   d.Get("file_name").(string),
+		FileId:     d.Get("file_id").(string),
+		SseCKeyB64: d.Get("sse_c_key_b64").(string),
+		MaxSize:    d.Get("max_size").(int),
+		OutputPath: d.Get("output_path").(string),
+	}
+	if !d.GetRawConfig().GetAttr("range_start").IsNull() {
+		input.ByteRange = []int{d.Get("range_start").(int), d.Get("range_end").(int)}
+	}
+
+	var output BucketFileContentOutput
+	err := client.Apply(ctx, OpDataSourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// These fields are not returned by the API but are needed for the data source
+	output.SseCKeyB64 = input.SseCKeyB64
+	output.MaxSize = input.MaxSize
+	output.OutputPath = input.OutputPath
+	output.RangeStart = d.Get("range_start").(int)
+	output.RangeEnd = d.Get("range_end").(int)
+	if content, err := base64.StdEncoding.DecodeString(output.ContentBase64); err == nil && utf8.Valid(content) {
+		output.Content = string(content)
+	}
+
+	d.SetId(output.FileId)
+
+	err = client.Populate(ctx, OpDataSourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,323 @@
+//####################################################################
+//
+// File: b2/data_source_b2_bucket_file_content_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceB2BucketFileContent_basic(t *testing.T) {
+	resourceName := "b2_bucket_file_version.test"
+	dataSourceName := "data.b2_bucket_file_content.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketFileContentConfig_basic(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_id", resourceName, "file_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "content", "hello"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_base64", "aGVsbG8="),
+					resource.TestCheckResourceAttr(dataSourceName, "content_length", "5"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "content_sha1", resourceName, "content_sha1"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_info.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_info.description", "the file"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_size", "1048576"),
+					resource.TestCheckResourceAttr(dataSourceName, "size", "5"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "upload_timestamp", resourceName, "upload_timestamp"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2BucketFileContent_fileId(t *testing.T) {
+	resourceName := "b2_bucket_file_version.test"
+	dataSourceName := "data.b2_bucket_file_content.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketFileContentConfig_fileId(bucketName, tempFile, "temp.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_id", resourceName, "file_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "content", "hello"),
+				),
+			},
+			{
+				Config:      testAccDataSourceB2BucketFileContentConfig_fileId(bucketName, tempFile, "other.txt"),
+				ExpectError: regexp.MustCompile(`is named "temp.txt", not "other.txt"`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2BucketFileContent_range(t *testing.T) {
+	dataSourceName := "data.b2_bucket_file_content.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketFileContentConfig_range(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "range_start", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "range_end", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "content", "ell"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_length", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "size", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2BucketFileContent_maxSize(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceB2BucketFileContentConfig_maxSize(bucketName, tempFile),
+				ExpectError: regexp.MustCompile(`File "temp.txt" is too large \(5 bytes\)`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2BucketFileContent_outputPath(t *testing.T) {
+	dataSourceName := "data.b2_bucket_file_content.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+	outputPath := filepath.ToSlash(filepath.Join(t.TempDir(), "output.txt"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketFileContentConfig_outputPath(bucketName, tempFile, outputPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "output_path", outputPath),
+					resource.TestCheckResourceAttr(dataSourceName, "content", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "content_base64", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "content_length", "5"),
+					testAccCheckFileContent(outputPath, "hello"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2BucketFileContent_sseC(t *testing.T) {
+	dataSourceName := "data.b2_bucket_file_content.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketFileContentConfig_sseC(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "content", "hello"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_sha1", "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFileContent(path string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if string(content) != expected {
+			return fmt.Errorf("expected %q in %s, got %q", expected, path, string(content))
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceB2BucketFileContentConfig_basic(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+  content_type = "text/plain"
+  file_info = {
+    description = "the file"
+  }
+}
+
+data "b2_bucket_file_content" "test" {
+  bucket_id = b2_bucket_file_version.test.bucket_id
+  file_name = b2_bucket_file_version.test.file_name
+}
+`, bucketName, tempFile)
+}
+
+func testAccDataSourceB2BucketFileContentConfig_fileId(bucketName string, tempFile string, fileName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+}
+
+data "b2_bucket_file_content" "test" {
+  bucket_id = b2_bucket_file_version.test.bucket_id
+  file_name = "%s"
+  file_id = b2_bucket_file_version.test.file_id
+}
+`, bucketName, tempFile, fileName)
+}
+
+func testAccDataSourceB2BucketFileContentConfig_range(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+}
+
+data "b2_bucket_file_content" "test" {
+  bucket_id = b2_bucket_file_version.test.bucket_id
+  file_name = b2_bucket_file_version.test.file_name
+  range_start = 1
+  range_end = 3
+}
+`, bucketName, tempFile)
+}
+
+func testAccDataSourceB2BucketFileContentConfig_maxSize(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+}
+
+data "b2_bucket_file_content" "test" {
+  bucket_id = b2_bucket_file_version.test.bucket_id
+  file_name = b2_bucket_file_version.test.file_name
+  max_size = 4
+}
+`, bucketName, tempFile)
+}
+
+func testAccDataSourceB2BucketFileContentConfig_outputPath(bucketName string, tempFile string, outputPath string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+}
+
+data "b2_bucket_file_content" "test" {
+  bucket_id = b2_bucket_file_version.test.bucket_id
+  file_name = b2_bucket_file_version.test.file_name
+  max_size = 4
+  output_path = "%s"
+}
+`, bucketName, tempFile, outputPath)
+}
+
+func testAccDataSourceB2BucketFileContentConfig_sseC(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+  server_side_encryption {
+    mode = "SSE-C"
+    algorithm = "AES256"
+    key {
+      secret_b64 = "notarealkey11111111111111111111111111111111="
+    }
+  }
+}
+
+data "b2_bucket_file_content" "test" {
+  bucket_id = b2_bucket_file_version.test.bucket_id
+  file_name = b2_bucket_file_version.test.file_name
+  sse_c_key_b64 = "notarealkey11111111111111111111111111111111="
+}
+`, bucketName, tempFile)
+}
@@ -13,6 +13,7 @@ package b2
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -34,6 +35,7 @@ func TestAccDataSourceB2BucketFile_noFiles(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "bucket_id", parentResourceName, "bucket_id"),
 					resource.TestCheckResourceAttr(dataSourceName, "file_name", "non_existing_file.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "false"),
 					resource.TestCheckResourceAttr(dataSourceName, "file_versions.#", "0"),
 					resource.TestCheckResourceAttr(dataSourceName, "show_versions", "false"),
 				),
@@ -60,6 +62,7 @@ func TestAccDataSourceB2BucketFile_singleFile(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "bucket_id", parentResourceName, "bucket_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_name", resourceName, "file_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "true"),
 					resource.TestCheckResourceAttr(dataSourceName, "file_versions.#", "1"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.action", resourceName, "action"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.content_md5", resourceName, "content_md5"),
@@ -69,9 +72,17 @@ func TestAccDataSourceB2BucketFile_singleFile(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.file_id", resourceName, "file_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.file_info", resourceName, "file_info"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.file_name", resourceName, "file_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.0.file_retention.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.legal_hold", resourceName, "legal_hold"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.0.file_retention_readable", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.0.legal_hold_readable", "true"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.server_side_encryption", resourceName, "server_side_encryption"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.size", resourceName, "size"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.upload_timestamp", resourceName, "upload_timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.replication_status", resourceName, "replication_status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.sse_c_key_id", resourceName, "sse_c_key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.src_last_modified_millis", resourceName, "src_last_modified_millis"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.large_file_sha1", resourceName, "large_file_sha1"),
 					resource.TestCheckResourceAttr(dataSourceName, "show_versions", "false"),
 				),
 			},
@@ -163,6 +174,117 @@ func TestAccDataSourceB2BucketFile_multipleFilesWithVersions(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceB2BucketFile_bucketNotFound(t *testing.T) {
+	dataSourceName := "data.b2_bucket_file.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceB2BucketFileConfig_bucketNotFound("true"),
+				ExpectError: regexp.MustCompile("BucketIdNotFound|Bucket with id=.* not found"),
+			},
+			{
+				Config: testAccDataSourceB2BucketFileConfig_bucketNotFound("false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2BucketFile_selectVersion(t *testing.T) {
+	resource1Name := "b2_bucket_file_version.test1"
+	resource2Name := "b2_bucket_file_version.test2"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketFileConfig_selectVersion(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.b2_bucket_file.latest", "exists", "true"),
+					resource.TestCheckResourceAttr("data.b2_bucket_file.latest", "action", "upload"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_file.latest", "file_id", resource2Name, "file_id"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_file.latest", "content_sha1", resource2Name, "content_sha1"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_file.latest", "file_info", resource2Name, "file_info"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_file.latest", "size", resource2Name, "size"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_file.latest", "upload_timestamp", resource2Name, "upload_timestamp"),
+					resource.TestCheckResourceAttr("data.b2_bucket_file.at_time", "exists", "true"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_file.at_time", "file_id", resource1Name, "file_id"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_file.at_time", "file_info", resource1Name, "file_info"),
+					resource.TestCheckResourceAttr("data.b2_bucket_file.before_upload", "exists", "false"),
+					resource.TestCheckResourceAttr("data.b2_bucket_file.before_upload", "file_id", ""),
+					resource.TestCheckResourceAttr("data.b2_bucket_file.by_id", "exists", "true"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_file.by_id", "upload_timestamp", resource1Name, "upload_timestamp"),
+					resource.TestCheckResourceAttr("data.b2_bucket_file.by_missing_id", "exists", "false"),
+					resource.TestCheckResourceAttr("data.b2_bucket_file.by_missing_id", "file_id", "4_z0000000000000000000000_f000000000000000_d00000000_m000000_c000_v0000000_t0000"),
+				),
+			},
+			{
+				Config: testAccDataSourceB2BucketFileConfig_selectHiddenVersion(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.b2_bucket_file.hidden", "exists", "false"),
+					resource.TestCheckResourceAttr("data.b2_bucket_file.hidden", "content_sha1", ""),
+					resource.TestCheckResourceAttr("data.b2_bucket_file.include_hidden", "exists", "true"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_file.include_hidden", "file_id", resource2Name, "file_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2BucketFile_unfinishedLargeFile(t *testing.T) {
+	dataSourceName := "data.b2_bucket_file.test"
+	resourceName := "b2_bucket_file_version.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketFileConfig_unfinishedLargeFile(bucketName, tempFile, ""),
+			},
+			{
+				// the unfinished large file of the same name is not a version of the file
+				PreConfig: func() { testAccStartLargeFile(t, bucketName, "temp.txt", 1) },
+				Config:    testAccDataSourceB2BucketFileConfig_unfinishedLargeFile(bucketName, tempFile, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "action", "upload"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_id", resourceName, "file_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.file_id", resourceName, "file_id"),
+				),
+			},
+			{
+				// The unfinished large file has to be canceled for the bucket to be deleted
+				Config: testAccDataSourceB2BucketFileConfig_unfinishedLargeFile(bucketName, tempFile, `
+resource "b2_bucket_unfinished_large_files_cleanup" "test" {
+  bucket_id = b2_bucket.test.id
+  older_than = "1s"
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("b2_bucket_unfinished_large_files_cleanup.test", "canceled_files.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceB2BucketFileConfig_noFiles(bucketName string) string {
 	return fmt.Sprintf(`
 resource "b2_bucket" "test" {
@@ -244,3 +366,140 @@ data "b2_bucket_file" "test" {
 }
 `, bucketName, tempFile, showVersions)
 }
+
+func testAccDataSourceB2BucketFileConfig_bucketNotFound(failIfNotFound string) string {
+	return fmt.Sprintf(`
+data "b2_bucket_file" "test" {
+  bucket_id         = "0000000000000000000000000"
+  file_name         = "non_existing_file.txt"
+  fail_if_not_found = %s
+}
+`, failIfNotFound)
+}
+
+func testAccDataSourceB2BucketFileConfig_selectVersion(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test1" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+  file_info = {
+    description = "first version"
+  }
+}
+
+resource "b2_bucket_file_version" "test2" {
+  bucket_id = b2_bucket_file_version.test1.bucket_id
+  file_name = b2_bucket_file_version.test1.file_name
+  source = b2_bucket_file_version.test1.source
+  file_info = {
+    description = "second version"
+  }
+
+  depends_on = [
+    b2_bucket_file_version.test1,
+  ]
+}
+
+data "b2_bucket_file" "latest" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = b2_bucket_file_version.test2.file_name
+}
+
+data "b2_bucket_file" "at_time" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = b2_bucket_file_version.test2.file_name
+  at_time = b2_bucket_file_version.test1.upload_timestamp
+}
+
+data "b2_bucket_file" "before_upload" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = b2_bucket_file_version.test2.file_name
+  at_time = "2020-01-01T00:00:00Z"
+}
+
+data "b2_bucket_file" "by_id" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = b2_bucket_file_version.test2.file_name
+  file_id = b2_bucket_file_version.test1.file_id
+}
+
+data "b2_bucket_file" "by_missing_id" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = b2_bucket_file_version.test2.file_name
+  file_id = "4_z0000000000000000000000_f000000000000000_d00000000_m000000_c000_v0000000_t0000"
+}
+`, bucketName, tempFile)
+}
+
+func testAccDataSourceB2BucketFileConfig_selectHiddenVersion(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test1" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+  file_info = {
+    description = "first version"
+  }
+}
+
+resource "b2_bucket_file_version" "test2" {
+  bucket_id = b2_bucket_file_version.test1.bucket_id
+  file_name = b2_bucket_file_version.test1.file_name
+  source = b2_bucket_file_version.test1.source
+  file_info = {
+    description = "second version"
+  }
+
+  depends_on = [
+    b2_bucket_file_version.test1,
+  ]
+}
+
+resource "b2_bucket_file_hide" "test" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = b2_bucket_file_version.test2.file_name
+}
+
+data "b2_bucket_file" "hidden" {
+  bucket_id = b2_bucket_file_hide.test.bucket_id
+  file_name = b2_bucket_file_hide.test.file_name
+}
+
+data "b2_bucket_file" "include_hidden" {
+  bucket_id = b2_bucket_file_hide.test.bucket_id
+  file_name = b2_bucket_file_hide.test.file_name
+  include_hidden = true
+}
+`, bucketName, tempFile)
+}
+
+func testAccDataSourceB2BucketFileConfig_unfinishedLargeFile(bucketName string, tempFile string, extraConfig string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+}
+
+data "b2_bucket_file" "test" {
+  bucket_id = b2_bucket_file_version.test.bucket_id
+  file_name = b2_bucket_file_version.test.file_name
+}
+%s`, bucketName, tempFile, extraConfig)
+}
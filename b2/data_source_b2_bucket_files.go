@@ -12,6 +12,8 @@ package b2
 
 import (
 	"context"
+	"slices"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -46,12 +48,151 @@ func dataSourceB2BucketFiles() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
+			"include_glob": {
+				Description: "When provided, only the files whose names match the glob pattern are returned." +
+					" `*` and `?` do not match `/`, while `**` matches any number of folders.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"exclude_glob": {
+				Description: "When provided, the files whose names match the glob pattern are not returned." +
+					" `*` and `?` do not match `/`, while `**` matches any number of folders.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"include_regex": {
+				Description:  "When provided, only the files whose names match the regular expression are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"exclude_regex": {
+				Description:  "When provided, the files whose names match the regular expression are not returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"min_size": {
+				Description:  "When provided, only the files of at least the given size are returned. Folders are not filtered by size.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_size": {
+				Description:  "When provided, only the files of at most the given size are returned. Folders are not filtered by size.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"uploaded_after": {
+				Description:  "When provided, only the files uploaded after the given RFC 3339 timestamp are returned. Folders are not filtered by upload time.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"uploaded_before": {
+				Description:  "When provided, only the files uploaded before the given RFC 3339 timestamp are returned. Folders are not filtered by upload time.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"actions": {
+				Description: "When provided, only the file versions with the given actions are returned." +
+					" The subfolders listed in non-recursive mode have the `folder` action.",
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"start", "upload", "hide", "folder"}, false),
+				},
+				Optional: true,
+			},
+			"start_file_name": {
+				Description: "When provided, only the files whose names are not before the given name are returned." +
+					" Use `next_file_name` of the previous page to list the files page by page.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_file_id": {
+				Description: "When provided with `show_versions`, the versions of the file named `start_file_name` are returned" +
+					" from the version with the given ID. Use `next_file_id` of the previous page, together with `next_file_name`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start_file_name"},
+			},
+			"max_results": {
+				Description:  "When provided, at most the given number of file versions is returned, in file name order.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"sort_by": {
+				Description:  "The order of the returned file versions (name|size|upload_timestamp). The order applies after `max_results`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "name",
+				ValidateFunc: validation.StringInSlice([]string{"name", "size", "upload_timestamp"}, false),
+			},
+			"sort_descending": {
+				Description: "Sort in descending order.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"names_only": {
+				Description: "Do not return `file_versions`, only the lighter outputs.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 			"file_versions": {
 				Description: "File versions in the folder.",
 				Type:        schema.TypeList,
 				Elem:        getDataSourceFileVersionsElem(),
 				Computed:    true,
 			},
+			"file_names": {
+				Description: "The names of the returned files, without duplicates.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"sha1_by_name": {
+				Description: "The SHA1 hash of the latest uploaded version of the returned files, by file name.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"folders": {
+				Description: "The names of the returned subfolders, in non-recursive mode.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"next_file_name": {
+				Description: "The name of the first file that has not been returned because of `max_results`, empty if there is none.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"next_file_id": {
+				Description: "The ID of the first file version that has not been returned because of `max_results`, empty if there is none." +
+					" It continues the listing of a file that has more versions than `max_results`.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_count": {
+				Description: "The number of the returned file versions, also in `names_only` mode.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"total_size": {
+				Description: "The total size of the returned file versions, also in `names_only` mode.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -60,10 +201,26 @@ func dataSourceB2BucketFilesRead(ctx context.Context, d *schema.ResourceData, me
 	client := meta.(*Client)
 
 	input := BucketFilesInput{
-		BucketId:     d.Get("bucket_id").(string),
-		FolderName:   d.Get("folder_name").(string),
-		ShowVersions: d.Get("show_versions").(bool),
-		Recursive:    d.Get("recursive").(bool),
+		BucketId:       d.Get("bucket_id").(string),
+		FolderName:     d.Get("folder_name").(string),
+		ShowVersions:   d.Get("show_versions").(bool),
+		Recursive:      d.Get("recursive").(bool),
+		IncludeGlob:    d.Get("include_glob").(string),
+		ExcludeGlob:    d.Get("exclude_glob").(string),
+		IncludeRegex:   d.Get("include_regex").(string),
+		ExcludeRegex:   d.Get("exclude_regex").(string),
+		MinSize:        d.Get("min_size").(int),
+		MaxSize:        d.Get("max_size").(int),
+		UploadedAfter:  d.Get("uploaded_after").(string),
+		UploadedBefore: d.Get("uploaded_before").(string),
+		Actions:        d.Get("actions").(*schema.Set).List(),
+		StartFileName:  d.Get("start_file_name").(string),
+		StartFileId:    d.Get("start_file_id").(string),
+		MaxResults:     d.Get("max_results").(int),
+		SortBy:         d.Get("sort_by").(string),
+		SortDescending: d.Get("sort_descending").(bool),
+		NamesOnly:      d.Get("names_only").(bool),
+		FilterMaxSize:  !d.GetRawConfig().GetAttr("max_size").IsNull(),
 	}
 
 	var output BucketFilesOutput
@@ -72,6 +229,69 @@ func dataSourceB2BucketFilesRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
+	// The entries are filtered by the bindings and listed in file name order
+	entries := output.Entries
+
+	nextFileName, nextFileId := "", ""
+	if input.MaxResults > 0 && len(entries) > input.MaxResults {
+		cut := input.MaxResults
+		// Do not split the versions of a file between pages, unless they do not fit in one
+		for cut > 0 && entries[cut-1].name() == entries[cut].name() {
+			cut--
+		}
+		if cut == 0 {
+			cut = input.MaxResults
+		}
+		nextFileName = entries[cut].name()
+		if entries[cut].FolderName == "" {
+			nextFileId = entries[cut].FileId
+		}
+		entries = entries[:cut]
+	}
+
+	// The versions of a file are listed from the latest one
+	sha1ByName := map[string]string{}
+	totalCount, totalSize := 0, 0
+	for _, entry := range entries {
+		if entry.FolderName != "" {
+			continue
+		}
+		if _, ok := sha1ByName[entry.FileName]; !ok && entry.Action == "upload" {
+			sha1ByName[entry.FileName] = entry.ContentSha1
+		}
+		totalCount++
+		totalSize += entry.Size
+	}
+
+	sortBucketFilesEntries(entries, input.SortBy, input.SortDescending)
+
+	fileVersions := []FileVersion{}
+	fileNames := []string{}
+	folders := []string{}
+	for _, entry := range entries {
+		if !input.NamesOnly {
+			fileVersions = append(fileVersions, entry.FileVersion)
+		}
+		if entry.FolderName != "" {
+			folders = append(folders, entry.FolderName)
+			continue
+		}
+		if !slices.Contains(fileNames, entry.FileName) {
+			fileNames = append(fileNames, entry.FileName)
+		}
+	}
+
+	// These fields are not returned by the API but are needed for the data source
+	output.BucketFilesInput = input
+	output.FileVersions = fileVersions
+	output.FileNames = fileNames
+	output.Sha1ByName = sha1ByName
+	output.Folders = folders
+	output.NextFileName = nextFileName
+	output.NextFileId = nextFileId
+	output.TotalCount = totalCount
+	output.TotalSize = totalSize
+
 	d.SetId(output.Sha1)
 
 	err = client.Populate(ctx, OpDataSourceRead, &output, d)
@@ -81,3 +301,31 @@ func dataSourceB2BucketFilesRead(ctx context.Context, d *schema.ResourceData, me
 
 	return nil
 }
+
+// name returns the name of the subfolder for the subfolders listed in non-recursive mode,
+// which come with one of the files in the subfolder, and the file name otherwise.
+func (e BucketFilesEntry) name() string {
+	if e.FolderName != "" {
+		return e.FolderName
+	}
+	return e.FileName
+}
+
+func sortBucketFilesEntries(entries []BucketFilesEntry, sortBy string, descending bool) {
+	less := func(a, b BucketFilesEntry) bool {
+		switch sortBy {
+		case "size":
+			return a.Size < b.Size
+		case "upload_timestamp":
+			return a.UploadTimestamp < b.UploadTimestamp
+		default:
+			return a.name() < b.name()
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if descending {
+			return less(entries[j], entries[i])
+		}
+		return less(entries[i], entries[j])
+	})
+}
@@ -69,9 +69,17 @@ func TestAccDataSourceB2BucketFiles_singleFile(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.file_id", resourceName, "file_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.file_info", resourceName, "file_info"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.file_name", resourceName, "file_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.0.file_retention.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.legal_hold", resourceName, "legal_hold"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.0.file_retention_readable", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.0.legal_hold_readable", "true"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.server_side_encryption", resourceName, "server_side_encryption"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.size", resourceName, "size"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.upload_timestamp", resourceName, "upload_timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.replication_status", resourceName, "replication_status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.sse_c_key_id", resourceName, "sse_c_key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.src_last_modified_millis", resourceName, "src_last_modified_millis"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.large_file_sha1", resourceName, "large_file_sha1"),
 					resource.TestCheckResourceAttr(dataSourceName, "folder_name", ""),
 				),
 			},
@@ -185,6 +193,87 @@ func TestAccDataSourceB2BucketFiles_multipleFilesWithVersions(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceB2BucketFiles_filters(t *testing.T) {
+	resourceName := "b2_bucket_file_version.test1"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketFilesConfig_filters(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.b2_bucket_files.glob", "file_names.#", "2"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.glob", "file_names.0", "a.txt"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.glob", "file_names.1", "dir/c.txt"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.glob", "file_versions.#", "2"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_files.glob", "sha1_by_name.a.txt", resourceName, "content_sha1"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.regex", "file_names.#", "1"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.regex", "file_names.0", "b.log"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.size", "file_names.#", "0"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.size", "total_count", "0"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.names_only", "file_versions.#", "0"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.names_only", "file_names.#", "2"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.names_only", "folders.#", "1"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.names_only", "folders.0", "dir/"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.names_only", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.names_only", "total_size", "10"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.actions", "file_names.#", "0"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.actions", "folders.#", "1"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.sorted", "file_names.0", "dir/c.txt"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.sorted", "file_names.1", "b.log"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.sorted", "file_names.2", "a.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2BucketFiles_paging(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketFilesConfig_paging(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page1", "file_names.#", "2"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page1", "file_names.0", "a.txt"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page1", "file_names.1", "b.log"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page1", "next_file_name", "dir/c.txt"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page1", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page1", "total_size", "10"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page2", "file_names.#", "1"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page2", "file_names.0", "dir/c.txt"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page2", "next_file_name", ""),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page2", "total_count", "1"),
+				),
+			},
+			{
+				// the versions of a file that do not fit in one page are continued from next_file_id
+				Config: testAccDataSourceB2BucketFilesConfig_pagingVersions(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page1", "file_versions.#", "2"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page1", "next_file_name", "a.txt"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_files.page1", "next_file_id", "b2_bucket_file_version.test1", "file_id"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page2", "file_versions.#", "2"),
+					resource.TestCheckResourceAttrPair("data.b2_bucket_files.page2", "file_versions.0.file_id", "b2_bucket_file_version.test1", "file_id"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page2", "file_versions.1.file_name", "b.log"),
+					resource.TestCheckResourceAttr("data.b2_bucket_files.page2", "next_file_name", "dir/c.txt"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceB2BucketFilesConfig_noFiles(bucketName string, tempFile string) string {
 	return fmt.Sprintf(`
 resource "b2_bucket" "test" {
@@ -270,3 +359,161 @@ data "b2_bucket_files" "test" {
 }
 `, bucketName, tempFile, showVersions)
 }
+
+func testAccDataSourceB2BucketFilesConfig_filters(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+resource "b2_bucket_file_version" "test1" {
+  bucket_id = b2_bucket.test.id
+  file_name = "a.txt"
+  source = "%s"
+}
+
+resource "b2_bucket_file_version" "test2" {
+  bucket_id = b2_bucket_file_version.test1.bucket_id
+  file_name = "b.log"
+  source = b2_bucket_file_version.test1.source
+}
+
+resource "b2_bucket_file_version" "test3" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = "dir/c.txt"
+  source = b2_bucket_file_version.test2.source
+}
+
+data "b2_bucket_files" "glob" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  recursive = true
+  include_glob = "**/*.txt"
+}
+
+data "b2_bucket_files" "regex" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  recursive = true
+  exclude_regex = "\\.txt$"
+}
+
+data "b2_bucket_files" "size" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  recursive = true
+  min_size = 6
+  uploaded_after = "2020-01-01T00:00:00Z"
+}
+
+data "b2_bucket_files" "names_only" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  names_only = true
+}
+
+data "b2_bucket_files" "actions" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  actions = ["folder"]
+}
+
+data "b2_bucket_files" "sorted" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  recursive = true
+  sort_by = "name"
+  sort_descending = true
+}
+`, bucketName, tempFile)
+}
+
+func testAccDataSourceB2BucketFilesConfig_paging(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+resource "b2_bucket_file_version" "test1" {
+  bucket_id = b2_bucket.test.id
+  file_name = "a.txt"
+  source = "%s"
+}
+
+resource "b2_bucket_file_version" "test2" {
+  bucket_id = b2_bucket_file_version.test1.bucket_id
+  file_name = "b.log"
+  source = b2_bucket_file_version.test1.source
+}
+
+resource "b2_bucket_file_version" "test3" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = "dir/c.txt"
+  source = b2_bucket_file_version.test2.source
+}
+
+data "b2_bucket_files" "page1" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  recursive = true
+  max_results = 2
+}
+
+data "b2_bucket_files" "page2" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  recursive = true
+  max_results = 2
+  start_file_name = data.b2_bucket_files.page1.next_file_name
+}
+`, bucketName, tempFile)
+}
+
+func testAccDataSourceB2BucketFilesConfig_pagingVersions(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+resource "b2_bucket_file_version" "test1" {
+  bucket_id = b2_bucket.test.id
+  file_name = "a.txt"
+  source = "%s"
+}
+
+resource "b2_bucket_file_version" "test2" {
+  bucket_id = b2_bucket_file_version.test1.bucket_id
+  file_name = "b.log"
+  source = b2_bucket_file_version.test1.source
+}
+
+resource "b2_bucket_file_version" "test3" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = "dir/c.txt"
+  source = b2_bucket_file_version.test2.source
+}
+
+resource "b2_bucket_file_version" "test4" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  file_name = "a.txt"
+  source = b2_bucket_file_version.test3.source
+}
+
+resource "b2_bucket_file_version" "test5" {
+  bucket_id = b2_bucket_file_version.test4.bucket_id
+  file_name = "a.txt"
+  source = b2_bucket_file_version.test4.source
+}
+
+data "b2_bucket_files" "page1" {
+  bucket_id = b2_bucket_file_version.test5.bucket_id
+  show_versions = true
+  recursive = true
+  max_results = 2
+}
+
+data "b2_bucket_files" "page2" {
+  bucket_id = b2_bucket_file_version.test5.bucket_id
+  show_versions = true
+  recursive = true
+  max_results = 2
+  start_file_name = data.b2_bucket_files.page1.next_file_name
+  start_file_id = data.b2_bucket_files.page1.next_file_id
+}
+`, bucketName, tempFile)
+}
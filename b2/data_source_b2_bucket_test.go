@@ -12,6 +12,7 @@ package b2
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -37,6 +38,7 @@ func TestAccDataSourceB2Bucket_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "bucket_name", bucketName),
 					resource.TestCheckResourceAttrPair(dataSourceName, "bucket_name", resourceName, "bucket_name"),
 					resource.TestCheckResourceAttr(dataSourceName, "bucket_type", "allPublic"),
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "true"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "bucket_type", resourceName, "bucket_type"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "cors_rules", resourceName, "cors_rules"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "default_server_side_encryption", resourceName, "default_server_side_encryption"),
@@ -102,6 +104,55 @@ func TestAccDataSourceB2Bucket_all(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceB2Bucket_byId(t *testing.T) {
+	resourceName := "b2_bucket.test"
+	dataSourceName := "data.b2_bucket.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketConfig_byId(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket_id", resourceName, "bucket_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_name", bucketName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket_type", resourceName, "bucket_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "revision", resourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2Bucket_notFound(t *testing.T) {
+	dataSourceName := "data.b2_bucket.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceB2BucketConfig_notFound(bucketName, "true"),
+				ExpectError: regexp.MustCompile("NonExistentBucket|No such bucket"),
+			},
+			{
+				Config: testAccDataSourceB2BucketConfig_notFound(bucketName, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exists", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_name", bucketName),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_id", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_type", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceB2BucketConfig_basic(bucketName string) string {
 	return fmt.Sprintf(`
 resource "b2_bucket" "test" {
@@ -161,3 +212,25 @@ data "b2_bucket" "test" {
 }
 `, bucketName)
 }
+
+func testAccDataSourceB2BucketConfig_byId(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+data "b2_bucket" "test" {
+  bucket_id = b2_bucket.test.bucket_id
+}
+`, bucketName)
+}
+
+func testAccDataSourceB2BucketConfig_notFound(bucketName string, failIfNotFound string) string {
+	return fmt.Sprintf(`
+data "b2_bucket" "test" {
+  bucket_name       = "%s"
+  fail_if_not_found = %s
+}
+`, bucketName, failIfNotFound)
+}
@@ -0,0 +1,96 @@
+//####################################################################
+//
+// File: b2/data_source_b2_bucket_unfinished_large_files.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceB2BucketUnfinishedLargeFiles() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 bucket unfinished large files data source. Lists the large files whose upload has been started but neither finished nor canceled.",
+
+		ReadContext: dataSourceB2BucketUnfinishedLargeFilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Description:  "The ID of the bucket.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name_prefix": {
+				Description: "When provided, only the files whose names start with the prefix are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"older_than": {
+				Description:  "When provided, only the files whose upload was started longer ago than the given duration, such as \"168h\", are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+			},
+			"files": {
+				Description: "The unfinished large files, sorted by name.",
+				Type:        schema.TypeList,
+				Elem:        getUnfinishedLargeFileElem(),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceB2BucketUnfinishedLargeFilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketUnfinishedLargeFilesInput{
+		BucketId:      d.Get("bucket_id").(string),
+		NamePrefix:    d.Get("name_prefix").(string),
+		OlderThan:     d.Get("older_than").(string),
+		StartedBefore: startedBefore(d.Get("older_than").(string)),
+	}
+
+	var output BucketUnfinishedLargeFilesOutput
+	err := client.Apply(ctx, OpDataSourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// These fields are not returned by the API but are needed for the data source
+	output.BucketUnfinishedLargeFilesInput = input
+	for i := range output.Files {
+		output.Files[i].StartTime = formatTimestamp(output.Files[i].UploadTimestamp)
+	}
+
+	d.SetId(output.Sha1)
+
+	err = client.Populate(ctx, OpDataSourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// startedBefore returns the start time, in milliseconds since 1970, of the unfinished large files
+// that are older than the given duration, or 0 when no duration is given.
+func startedBefore(olderThan string) int {
+	if olderThan == "" {
+		return 0
+	}
+	duration, _ := time.ParseDuration(olderThan)
+	return int(time.Now().Add(-duration).UnixMilli())
+}
@@ -0,0 +1,140 @@
+//####################################################################
+//
+// File: b2/data_source_b2_bucket_unfinished_large_files_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceB2BucketUnfinishedLargeFiles_basic(t *testing.T) {
+	parentResourceName := "b2_bucket.test"
+	dataSourceName := "data.b2_bucket_unfinished_large_files.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketUnfinishedLargeFilesConfig_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket_id", parentResourceName, "bucket_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name_prefix", "temp/"),
+					resource.TestCheckResourceAttr(dataSourceName, "older_than", "168h"),
+					resource.TestCheckResourceAttr(dataSourceName, "files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2BucketUnfinishedLargeFiles_started(t *testing.T) {
+	dataSourceName := "data.b2_bucket_unfinished_large_files.test"
+	olderDataSourceName := "data.b2_bucket_unfinished_large_files.older"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketUnfinishedLargeFilesConfig_bucket(bucketName),
+			},
+			{
+				PreConfig: func() { testAccStartLargeFile(t, bucketName, "temp/large.bin", 2) },
+				Config:    testAccDataSourceB2BucketUnfinishedLargeFilesConfig_started(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "files.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "files.0.file_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "files.0.file_name", "temp/large.bin"),
+					resource.TestCheckResourceAttr(dataSourceName, "files.0.content_type", "application/octet-stream"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "files.0.upload_timestamp"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "files.0.start_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "files.0.part_count", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "files.0.uploaded_bytes", strconv.Itoa(2*testAccLargeFilePartSize)),
+					resource.TestCheckResourceAttr(olderDataSourceName, "files.#", "0"),
+				),
+			},
+			{
+				// The unfinished large file has to be canceled for the bucket to be deleted
+				Config: testAccDataSourceB2BucketUnfinishedLargeFilesConfig_canceled(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("b2_bucket_unfinished_large_files_cleanup.test", "canceled_files.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceB2BucketUnfinishedLargeFilesConfig_basic(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+data "b2_bucket_unfinished_large_files" "test" {
+  bucket_id = b2_bucket.test.id
+  name_prefix = "temp/"
+  older_than = "168h"
+}
+`, bucketName)
+}
+
+func testAccDataSourceB2BucketUnfinishedLargeFilesConfig_bucket(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+`, bucketName)
+}
+
+func testAccDataSourceB2BucketUnfinishedLargeFilesConfig_started(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+data "b2_bucket_unfinished_large_files" "test" {
+  bucket_id = b2_bucket.test.id
+  name_prefix = "temp/"
+}
+
+data "b2_bucket_unfinished_large_files" "older" {
+  bucket_id = b2_bucket.test.id
+  name_prefix = "temp/"
+  older_than = "168h"
+}
+`, bucketName)
+}
+
+func testAccDataSourceB2BucketUnfinishedLargeFilesConfig_canceled(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_unfinished_large_files_cleanup" "test" {
+  bucket_id = b2_bucket.test.id
+  older_than = "1s"
+}
+`, bucketName)
+}
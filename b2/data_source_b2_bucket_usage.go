@@ -0,0 +1,162 @@
+//####################################################################
+//
+// File: b2/data_source_b2_bucket_usage.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// bytesPerGb is the number of bytes in a GB, as used by B2 pricing.
+const bytesPerGb = 1000 * 1000 * 1000
+
+func dataSourceB2BucketUsage() *schema.Resource {
+	dataSourceSchema := map[string]*schema.Schema{
+		"bucket_id": {
+			Description:  "The ID of the bucket.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"folder_name": {
+			Description: "The folder name (B2 file name prefix).",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"group_by_folder": {
+			Description: "Also sum up the usage of each top-level folder, in `folders`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"price_per_gb": {
+			Description:  "The monthly storage price per GB (10^9 bytes) used for `estimated_monthly_cost`.",
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+		"folders": {
+			Description: "The usage of each top-level folder, sorted by folder name, when `group_by_folder` is true." +
+				" The files that are not in a subfolder are summed up with an empty folder name.",
+			Type:     schema.TypeList,
+			Elem:     getDataSourceBucketUsageFolderElem(),
+			Computed: true,
+		},
+	}
+	for k, v := range getBucketUsageTotalsSchema() {
+		dataSourceSchema[k] = v
+	}
+
+	return &schema.Resource{
+		Description: "B2 bucket usage data source. Sums up the storage used by the file versions in a bucket, or in a folder of a bucket." +
+			" The bucket is listed as a stream, so that large buckets do not require much memory, but listing many files takes time.",
+
+		ReadContext: dataSourceB2BucketUsageRead,
+
+		Schema: dataSourceSchema,
+	}
+}
+
+func getBucketUsageTotalsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"total_bytes": {
+			Description: "The total size of all the file versions, including the hidden ones.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"file_count": {
+			Description: "The number of files whose latest version is not a hide marker.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"version_count": {
+			Description: "The number of file versions, including hide markers.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"hidden_bytes": {
+			Description: "The total size of the versions of the files whose latest version is a hide marker.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"unfinished_large_file_bytes": {
+			Description: "The total size of the parts uploaded for unfinished large files.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"unfinished_large_file_count": {
+			Description: "The number of unfinished large files.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"estimated_monthly_cost": {
+			Description: "The estimated monthly storage cost of `total_bytes` and `unfinished_large_file_bytes`, given `price_per_gb`.",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+	}
+}
+
+func getDataSourceBucketUsageFolderElem() *schema.Resource {
+	elemSchema := map[string]*schema.Schema{
+		"folder_name": {
+			Description: "The name of the top-level folder, ending with a slash.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+	for k, v := range getBucketUsageTotalsSchema() {
+		elemSchema[k] = v
+	}
+
+	return &schema.Resource{
+		Schema: elemSchema,
+	}
+}
+
+func dataSourceB2BucketUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketUsageInput{
+		BucketId:      d.Get("bucket_id").(string),
+		FolderName:    d.Get("folder_name").(string),
+		GroupByFolder: d.Get("group_by_folder").(bool),
+		PricePerGb:    d.Get("price_per_gb").(float64),
+	}
+
+	var output BucketUsageOutput
+	err := client.Apply(ctx, OpDataSourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// These fields are not returned by the API but are needed for the data source
+	output.BucketUsageInput = input
+	output.EstimatedMonthlyCost = estimateMonthlyCost(output.BucketUsageTotals, input.PricePerGb)
+	for i := range output.Folders {
+		output.Folders[i].EstimatedMonthlyCost = estimateMonthlyCost(output.Folders[i].BucketUsageTotals, input.PricePerGb)
+	}
+
+	d.SetId(output.Sha1)
+
+	err = client.Populate(ctx, OpDataSourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func estimateMonthlyCost(totals BucketUsageTotals, pricePerGb float64) float64 {
+	return float64(totals.TotalBytes+totals.UnfinishedLargeFileBytes) * pricePerGb / bytesPerGb
+}
@@ -0,0 +1,169 @@
+//####################################################################
+//
+// File: b2/data_source_b2_bucket_usage_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceB2BucketUsage_basic(t *testing.T) {
+	parentResourceName := "b2_bucket.test"
+	dataSourceName := "data.b2_bucket_usage.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketUsageConfig_basic(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket_id", parentResourceName, "bucket_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "total_bytes", "15"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "version_count", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "hidden_bytes", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "unfinished_large_file_bytes", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "unfinished_large_file_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "estimated_monthly_cost", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2BucketUsage_groupByFolder(t *testing.T) {
+	dataSourceName := "data.b2_bucket_usage.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketUsageConfig_groupByFolder(bucketName, tempFile, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "total_bytes", "15"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_count", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "estimated_monthly_cost", "0.015"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.0.folder_name", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.0.total_bytes", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.0.file_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.0.estimated_monthly_cost", "0.005"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.1.folder_name", "dir/"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.1.total_bytes", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.1.file_count", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.1.version_count", "2"),
+				),
+			},
+			{
+				Config: testAccDataSourceB2BucketUsageConfig_groupByFolder(bucketName, tempFile, "dir"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "folder_name", "dir"),
+					resource.TestCheckResourceAttr(dataSourceName, "total_bytes", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_count", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "estimated_monthly_cost", "0.01"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.0.folder_name", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.1.folder_name", "sub/"),
+					resource.TestCheckResourceAttr(dataSourceName, "folders.1.total_bytes", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceB2BucketUsageConfig_basic(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test1" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp1.txt"
+  source = "%s"
+}
+
+resource "b2_bucket_file_version" "test2" {
+  bucket_id = b2_bucket_file_version.test1.bucket_id
+  file_name = b2_bucket_file_version.test1.file_name
+  source = b2_bucket_file_version.test1.source
+
+  depends_on = [
+    b2_bucket_file_version.test1,
+  ]
+}
+
+resource "b2_bucket_file_version" "test3" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = "temp2.txt"
+  source = b2_bucket_file_version.test2.source
+}
+
+resource "b2_bucket_file_hide" "test" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  file_name = b2_bucket_file_version.test3.file_name
+}
+
+data "b2_bucket_usage" "test" {
+  bucket_id = b2_bucket_file_hide.test.bucket_id
+}
+`, bucketName, tempFile)
+}
+
+func testAccDataSourceB2BucketUsageConfig_groupByFolder(bucketName string, tempFile string, folderName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_file_version" "test1" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+}
+
+resource "b2_bucket_file_version" "test2" {
+  bucket_id = b2_bucket_file_version.test1.bucket_id
+  file_name = "dir/temp.txt"
+  source = b2_bucket_file_version.test1.source
+}
+
+resource "b2_bucket_file_version" "test3" {
+  bucket_id = b2_bucket_file_version.test2.bucket_id
+  file_name = "dir/sub/temp.txt"
+  source = b2_bucket_file_version.test2.source
+}
+
+data "b2_bucket_usage" "test" {
+  bucket_id = b2_bucket_file_version.test3.bucket_id
+  folder_name = "%s"
+  group_by_folder = true
+  price_per_gb = 1000000
+}
+`, bucketName, tempFile, folderName)
+}
@@ -0,0 +1,197 @@
+//####################################################################
+//
+// File: b2/data_source_b2_buckets.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// bucketsBucketAttributes lists the attributes of the b2_bucket data source
+// that are returned for each bucket by the b2_buckets data source.
+var bucketsBucketAttributes = []string{
+	"account_id", "bucket_info", "bucket_type", "cors_rules", "file_lock_configuration",
+	"default_server_side_encryption", "lifecycle_rules", "replication_configuration", "options", "revision",
+}
+
+func dataSourceB2Buckets() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 buckets data source. Lists the buckets accessible with the provider's application key.",
+
+		ReadContext: dataSourceB2BucketsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Description: "When provided, only the buckets whose names start with the prefix are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name_regex": {
+				Description:  "When provided, only the buckets whose names match the regular expression are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"bucket_type": {
+				Description:  "When provided, only the buckets of the given type are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"bucket_info": {
+				Description: "When provided, only the buckets whose information contains all the given keys and values are returned.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"file_lock_enabled": {
+				Description: "When provided, only the buckets with File Lock enabled, or disabled, are returned.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"default_encryption_mode": {
+				Description:  "When provided, only the buckets with the given default server-side encryption mode are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "SSE-B2"}, false),
+			},
+			"buckets": {
+				Description: "The buckets, sorted by name.",
+				Type:        schema.TypeList,
+				Elem:        getDataSourceBucketsElem(),
+				Computed:    true,
+			},
+			"bucket_ids_by_name": {
+				Description: "The IDs of the buckets, by bucket name.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getDataSourceBucketsElem() *schema.Resource {
+	bucketSchema := dataSourceB2Bucket().Schema
+
+	elemSchema := map[string]*schema.Schema{
+		"bucket_id": {
+			Description: "The ID of the bucket.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"bucket_name": {
+			Description: "The name of the bucket.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+	for _, k := range bucketsBucketAttributes {
+		elemSchema[k] = bucketSchema[k]
+	}
+
+	return &schema.Resource{
+		Schema: elemSchema,
+	}
+}
+
+func dataSourceB2BucketsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketsInput{
+		NamePrefix:            d.Get("name_prefix").(string),
+		NameRegex:             d.Get("name_regex").(string),
+		BucketType:            d.Get("bucket_type").(string),
+		BucketInfo:            d.Get("bucket_info").(map[string]interface{}),
+		FileLockEnabled:       d.Get("file_lock_enabled").(bool),
+		DefaultEncryptionMode: d.Get("default_encryption_mode").(string),
+	}
+
+	var output BucketsOutput
+	err := client.Apply(ctx, OpDataSourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if input.NameRegex != "" {
+		nameRegex = regexp.MustCompile(input.NameRegex)
+	}
+	filterFileLock := !d.GetRawConfig().GetAttr("file_lock_enabled").IsNull()
+
+	buckets := []BucketsBucket{}
+	bucketIdsByName := map[string]string{}
+	for _, bucket := range output.Buckets {
+		if !strings.HasPrefix(bucket.BucketName, input.NamePrefix) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(bucket.BucketName) {
+			continue
+		}
+		if input.BucketType != "" && bucket.BucketType != input.BucketType {
+			continue
+		}
+		if !bucketInfoContains(bucket.BucketInfo, input.BucketInfo) {
+			continue
+		}
+		if filterFileLock && bucketFileLockEnabled(bucket) != input.FileLockEnabled {
+			continue
+		}
+		if input.DefaultEncryptionMode != "" && bucketDefaultEncryptionMode(bucket) != input.DefaultEncryptionMode {
+			continue
+		}
+		buckets = append(buckets, bucket)
+		bucketIdsByName[bucket.BucketName] = bucket.BucketId
+	}
+
+	// These fields are not returned by the API but are needed for the data source
+	output.BucketsInput = input
+	output.Buckets = buckets
+	output.BucketIdsByName = bucketIdsByName
+
+	d.SetId(output.Sha1)
+
+	err = client.Populate(ctx, OpDataSourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func bucketInfoContains(bucketInfo map[string]string, tags map[string]interface{}) bool {
+	for k, v := range tags {
+		if value, ok := bucketInfo[k]; !ok || value != v.(string) {
+			return false
+		}
+	}
+	return true
+}
+
+func bucketFileLockEnabled(bucket BucketsBucket) bool {
+	return bucket.FileLockConfiguration != nil && bucket.FileLockConfiguration.IsFileLockEnabled
+}
+
+func bucketDefaultEncryptionMode(bucket BucketsBucket) string {
+	if bucket.DefaultServerSideEncryption == nil || bucket.DefaultServerSideEncryption.Mode == "" {
+		return "none"
+	}
+	return bucket.DefaultServerSideEncryption.Mode
+}
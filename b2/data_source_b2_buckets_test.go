@@ -0,0 +1,118 @@
+//####################################################################
+//
+// File: b2/data_source_b2_buckets_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceB2Buckets_basic(t *testing.T) {
+	resourceName := "b2_bucket.test"
+	dataSourceName := "data.b2_buckets.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketsConfig_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "buckets.0.account_id", resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "buckets.0.bucket_id", resourceName, "bucket_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.0.bucket_name", bucketName),
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.0.bucket_type", "allPrivate"),
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.0.bucket_info.environment", "test"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "buckets.0.revision", resourceName, "revision"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_ids_by_name.%", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, fmt.Sprintf("bucket_ids_by_name.%s", bucketName), resourceName, "bucket_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceB2Buckets_filters(t *testing.T) {
+	dataSourceName := "data.b2_buckets.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceB2BucketsConfig_filters(bucketName, "allPublic", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_ids_by_name.%", "0"),
+				),
+			},
+			{
+				Config: testAccDataSourceB2BucketsConfig_filters(bucketName, "allPrivate", "production"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.#", "0"),
+				),
+			},
+			{
+				Config: testAccDataSourceB2BucketsConfig_filters(bucketName, "allPrivate", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.0.bucket_name", bucketName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceB2BucketsConfig_basic(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+  bucket_info = {
+    environment = "test"
+  }
+}
+
+data "b2_buckets" "test" {
+  name_prefix = b2_bucket.test.bucket_name
+}
+`, bucketName)
+}
+
+func testAccDataSourceB2BucketsConfig_filters(bucketName string, bucketType string, environment string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+  bucket_info = {
+    environment = "test"
+  }
+}
+
+data "b2_buckets" "test" {
+  name_regex              = "^${b2_bucket.test.bucket_name}$"
+  bucket_type             = "%s"
+  bucket_info             = {
+    environment = "%s"
+  }
+  file_lock_enabled       = false
+  default_encryption_mode = "none"
+}
+`, bucketName, bucketType, environment)
+}
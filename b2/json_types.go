@@ -67,18 +67,54 @@ type LifecycleRule struct {
 	DaysFromStartingToCancelingUnfinishedLargeFiles int    `json:"daysFromStartingToCancelingUnfinishedLargeFiles"`
 }
 
+type ReplicationRule struct {
+	DestinationBucketId  string `json:"destinationBucketId"`
+	FileNamePrefix       string `json:"fileNamePrefix"`
+	IncludeExistingFiles bool   `json:"includeExistingFiles"`
+	IsEnabled            bool   `json:"isEnabled"`
+	Priority             int    `json:"priority"`
+	ReplicationRuleName  string `json:"replicationRuleName"`
+}
+
+type ReplicationSource struct {
+	ReplicationRules       []ReplicationRule `json:"replicationRules"`
+	SourceApplicationKeyId string            `json:"sourceApplicationKeyId"`
+}
+
+type ReplicationDestination struct {
+	SourceToDestinationKeyMapping map[string]string `json:"sourceToDestinationKeyMapping"`
+}
+
+type ReplicationConfiguration struct {
+	AsReplicationSource      *ReplicationSource      `json:"asReplicationSource"`
+	AsReplicationDestination *ReplicationDestination `json:"asReplicationDestination"`
+}
+
+type FileRetention struct {
+	Mode                 string `json:"mode"`
+	RetainUntilTimestamp int    `json:"retainUntilTimestamp"`
+}
+
 type FileVersion struct {
-	Action               string                `json:"action"`
-	BucketId             string                `json:"bucketId"`
-	ContentMd5           string                `json:"contentMd5"`
-	ContentSha1          string                `json:"contentSha1"`
-	ContentType          string                `json:"contentType"`
-	FileId               string                `json:"fileId"`
-	FileInfo             map[string]string     `json:"fileInfo"`
-	FileName             string                `json:"fileName"`
-	ServerSideEncryption *ServerSideEncryption `json:"serverSideEncryption"`
-	Size                 int                   `json:"size"`
-	UploadTimestamp      int                   `json:"uploadTimestamp"`
+	Action                string                `json:"action"`
+	BucketId              string                `json:"bucketId"`
+	ContentMd5            string                `json:"contentMd5"`
+	ContentSha1           string                `json:"contentSha1"`
+	ContentType           string                `json:"contentType"`
+	FileId                string                `json:"fileId"`
+	FileInfo              map[string]string     `json:"fileInfo"`
+	FileName              string                `json:"fileName"`
+	FileRetention         *FileRetention        `json:"fileRetention"`
+	FileRetentionReadable bool                  `json:"fileRetentionReadable"`
+	LegalHold             string                `json:"legalHold"`
+	LegalHoldReadable     bool                  `json:"legalHoldReadable"`
+	ServerSideEncryption  *ServerSideEncryption `json:"serverSideEncryption"`
+	Size                  int                   `json:"size"`
+	UploadTimestamp       int                   `json:"uploadTimestamp"`
+	ReplicationStatus     string                `json:"replicationStatus"`
+	SseCKeyId             string                `json:"sseCKeyId"`
+	SrcLastModifiedMillis int                   `json:"srcLastModifiedMillis"`
+	LargeFileSha1         string                `json:"largeFileSha1"`
 }
 
 type CustomHeader struct {
@@ -125,6 +161,9 @@ type AccountInfoOutput struct {
 	S3ApiUrl                string    `json:"s3ApiUrl"`
 	RecommendedPartSize     int       `json:"recommendedPartSize"`
 	AbsoluteMinimumPartSize int       `json:"absoluteMinimumPartSize"`
+	ApplicationKeyId        string    `json:"applicationKeyId"`
+	// ApplicationKeyExpirationTimestamp is 0 when the key does not expire or its expiration is unknown
+	ApplicationKeyExpirationTimestamp int `json:"applicationKeyExpirationTimestamp"`
 }
 
 func (s *AccountInfoOutput) ResourceName() string {
@@ -140,16 +179,31 @@ func (s *AccountInfoInput) ResourceName() string {
 // ApplicationKey
 
 type ApplicationKeyOutput struct {
-	ApplicationKeyId       string        `json:"applicationKeyId"`
-	ApplicationKey         string        `json:"applicationKey"`
-	BucketIds              []interface{} `json:"bucketIds"`
-	BucketId               string        `json:"bucketId"` // deprecated
-	Capabilities           []interface{} `json:"capabilities"`
-	ExpirationTimestamp    int           `json:"expirationTimestamp"`
-	KeyName                string        `json:"keyName"`
-	NamePrefix             string        `json:"namePrefix"`
-	Options                []interface{} `json:"options"`
-	ValidDurationInSeconds int           `json:"validDurationInSeconds"`
+	AllowSelfDelete         bool                   `json:"allowSelfDelete"`
+	ApplicationKeyId        string                 `json:"applicationKeyId"`
+	ApplicationKey          string                 `json:"applicationKey"`
+	BucketIds               []interface{}          `json:"bucketIds"`
+	BucketId                string                 `json:"bucketId"` // deprecated
+	BucketNames             []interface{}          `json:"bucketNames"`
+	Capabilities            []interface{}          `json:"capabilities"`
+	CapabilityPreset        string                 `json:"capabilityPreset"`
+	EncryptedApplicationKey string                 `json:"encryptedApplicationKey"`
+	Exists                  bool                   `json:"exists"`
+	FailIfNotFound          bool                   `json:"failIfNotFound"`
+	ExpirationTime          string                 `json:"expirationTime"`
+	ExpirationTimestamp     int                    `json:"expirationTimestamp"`
+	Keepers                 map[string]interface{} `json:"keepers"`
+	KeyFingerprint          string                 `json:"keyFingerprint"`
+	KeyName                 string                 `json:"keyName"`
+	NamePrefix              string                 `json:"namePrefix"`
+	Options                 []interface{}          `json:"options"`
+	PgpKey                  string                 `json:"pgpKey"`
+	ResolvedBucketIds       []interface{}          `json:"resolvedBucketIds"`
+	RotateBeforeExpiry      string                 `json:"rotateBeforeExpiry"`
+	RotationPeriod          string                 `json:"rotationPeriod"`
+	RotationTimestamp       int                    `json:"rotationTimestamp"`
+	ValidDurationInSeconds  int                    `json:"validDurationInSeconds"`
+	ValidUntil              string                 `json:"validUntil"`
 }
 
 func (s *ApplicationKeyOutput) ResourceName() string {
@@ -158,11 +212,14 @@ func (s *ApplicationKeyOutput) ResourceName() string {
 
 type ApplicationKeyInput struct {
 	ApplicationKeyId       string        `json:"applicationKeyId,omitempty"`
+	ApplicationKey         string        `json:"applicationKey,omitempty"` // only used to verify imported keys
+	FailIfNotFound         bool          `json:"failIfNotFound,omitempty"`
 	KeyName                string        `json:"keyName,omitempty"`
 	Capabilities           []interface{} `json:"capabilities,omitempty"`
 	NamePrefix             string        `json:"namePrefix,omitempty"`
 	ValidDurationInSeconds int           `json:"validDurationInSeconds,omitempty"`
 	BucketIds              []interface{} `json:"bucketIds,omitempty"`
+	BucketNames            []interface{} `json:"bucketNames,omitempty"`
 	BucketId               string        `json:"bucketId,omitempty"` // deprecated
 	Apiver                 string        `json:"apiver,omitempty"`   // forces B2 Native API version for deprecated bucket_id
 }
@@ -171,20 +228,61 @@ func (s *ApplicationKeyInput) ResourceName() string {
 	return "application_key"
 }
 
+// ApplicationKeys
+
+type ApplicationKeysInput struct {
+	NamePrefix     string `json:"namePrefix"`
+	NameRegex      string `json:"nameRegex"`
+	Capability     string `json:"capability"`
+	BucketId       string `json:"bucketId"`
+	ExpiringWithin string `json:"expiringWithin"`
+	Unrestricted   bool   `json:"unrestricted"`
+}
+
+func (s *ApplicationKeysInput) ResourceName() string {
+	return "application_keys"
+}
+
+type ApplicationKeysKey struct {
+	ApplicationKeyId    string   `json:"applicationKeyId"`
+	KeyName             string   `json:"keyName"`
+	Capabilities        []string `json:"capabilities"`
+	BucketIds           []string `json:"bucketIds"`
+	NamePrefix          string   `json:"namePrefix"`
+	ExpirationTimestamp int      `json:"expirationTimestamp"`
+	ExpirationTime      string   `json:"expirationTime"`
+	Options             []string `json:"options"`
+}
+
+type ApplicationKeysOutput struct {
+	ApplicationKeysInput
+	Sha1              string               `json:"_sha1"`
+	Keys              []ApplicationKeysKey `json:"keys"`
+	ApplicationKeyIds []string             `json:"applicationKeyIds"`
+}
+
+func (s *ApplicationKeysOutput) ResourceName() string {
+	return "application_keys"
+}
+
 // Bucket
 
 type BucketOutput struct {
-	AccountId                   string                 `json:"accountId"`
-	BucketId                    string                 `json:"bucketId"`
-	BucketInfo                  map[string]string      `json:"bucketInfo"`
-	BucketName                  string                 `json:"bucketName"`
-	BucketType                  string                 `json:"bucketType"`
-	CorsRules                   []CorsRule             `json:"corsRules"`
-	DefaultServerSideEncryption *ServerSideEncryption  `json:"defaultServerSideEncryption"`
-	FileLockConfiguration       *FileLockConfiguration `json:"fileLockConfiguration"`
-	LifecycleRules              []LifecycleRule        `json:"lifecycleRules"`
-	Options                     []string               `json:"options"`
-	Revision                    int                    `json:"revision"`
+	AccountId                   string                    `json:"accountId"`
+	BucketId                    string                    `json:"bucketId"`
+	BucketInfo                  map[string]string         `json:"bucketInfo"`
+	BucketName                  string                    `json:"bucketName"`
+	BucketType                  string                    `json:"bucketType"`
+	CorsRules                   []CorsRule                `json:"corsRules"`
+	DefaultServerSideEncryption *ServerSideEncryption     `json:"defaultServerSideEncryption"`
+	FileLockConfiguration       *FileLockConfiguration    `json:"fileLockConfiguration"`
+	LifecycleRules              []LifecycleRule           `json:"lifecycleRules"`
+	IgnoreExternalRules         bool                      `json:"ignoreExternalRules"`
+	Exists                      bool                      `json:"exists"`
+	FailIfNotFound              bool                      `json:"failIfNotFound"`
+	Options                     []string                  `json:"options"`
+	ReplicationConfiguration    *ReplicationConfiguration `json:"replicationConfiguration"`
+	Revision                    int                       `json:"revision"`
 }
 
 func (s *BucketOutput) ResourceName() string {
@@ -201,18 +299,122 @@ type BucketInput struct {
 	FileLockConfiguration       []interface{}          `json:"fileLockConfiguration,omitempty"`
 	DefaultServerSideEncryption []interface{}          `json:"defaultServerSideEncryption,omitempty"`
 	LifecycleRules              []interface{}          `json:"lifecycleRules,omitempty"`
+	ReplicationConfiguration    []interface{}          `json:"replicationConfiguration,omitempty"`
+	IgnoreExternalRules         bool                   `json:"ignoreExternalRules,omitempty"`
+	PreviousCorsRules           []interface{}          `json:"previousCorsRules,omitempty"`
+	PreviousLifecycleRules      []interface{}          `json:"previousLifecycleRules,omitempty"`
+	FailIfNotFound              bool                   `json:"failIfNotFound,omitempty"`
 }
 
 func (s *BucketInput) ResourceName() string {
 	return "bucket"
 }
 
+// BucketCorsRule
+
+type BucketCorsRuleOutput struct {
+	BucketId          string   `json:"bucketId"`
+	CorsRuleName      string   `json:"corsRuleName"`
+	AllowedOrigins    []string `json:"allowedOrigins"`
+	AllowedOperations []string `json:"allowedOperations"`
+	MaxAgeSeconds     int      `json:"maxAgeSeconds"`
+	AllowedHeaders    []string `json:"allowedHeaders"`
+	ExposeHeaders     []string `json:"exposeHeaders"`
+	Revision          int      `json:"revision"`
+}
+
+func (s *BucketCorsRuleOutput) ResourceName() string {
+	return "bucket_cors_rule"
+}
+
+type BucketCorsRuleInput struct {
+	BucketId          string        `json:"bucketId"`
+	CorsRuleName      string        `json:"corsRuleName"`
+	AllowedOrigins    []interface{} `json:"allowedOrigins,omitempty"`
+	AllowedOperations []interface{} `json:"allowedOperations,omitempty"`
+	MaxAgeSeconds     int           `json:"maxAgeSeconds,omitempty"`
+	AllowedHeaders    []interface{} `json:"allowedHeaders,omitempty"`
+	ExposeHeaders     []interface{} `json:"exposeHeaders,omitempty"`
+}
+
+func (s *BucketCorsRuleInput) ResourceName() string {
+	return "bucket_cors_rule"
+}
+
+// BucketLifecycleRule
+
+type BucketLifecycleRuleOutput struct {
+	BucketId                                        string `json:"bucketId"`
+	FileNamePrefix                                  string `json:"fileNamePrefix"`
+	DaysFromHidingToDeleting                        int    `json:"daysFromHidingToDeleting"`
+	DaysFromUploadingToHiding                       int    `json:"daysFromUploadingToHiding"`
+	DaysFromStartingToCancelingUnfinishedLargeFiles int    `json:"daysFromStartingToCancelingUnfinishedLargeFiles"`
+	Revision                                        int    `json:"revision"`
+}
+
+func (s *BucketLifecycleRuleOutput) ResourceName() string {
+	return "bucket_lifecycle_rule"
+}
+
+type BucketLifecycleRuleInput struct {
+	BucketId                                        string `json:"bucketId"`
+	FileNamePrefix                                  string `json:"fileNamePrefix"`
+	DaysFromHidingToDeleting                        int    `json:"daysFromHidingToDeleting,omitempty"`
+	DaysFromUploadingToHiding                       int    `json:"daysFromUploadingToHiding,omitempty"`
+	DaysFromStartingToCancelingUnfinishedLargeFiles int    `json:"daysFromStartingToCancelingUnfinishedLargeFiles,omitempty"`
+}
+
+func (s *BucketLifecycleRuleInput) ResourceName() string {
+	return "bucket_lifecycle_rule"
+}
+
+// BucketReplication
+
+type BucketReplicationOutput struct {
+	SourceBucketId              string        `json:"sourceBucketId"`
+	DestinationBucketId         string        `json:"destinationBucketId"`
+	ReplicationRuleName         string        `json:"replicationRuleName"`
+	FileNamePrefix              string        `json:"fileNamePrefix"`
+	Priority                    int           `json:"priority"`
+	IncludeExistingFiles        bool          `json:"includeExistingFiles"`
+	IsEnabled                   bool          `json:"isEnabled"`
+	SourceApplicationKeyId      string        `json:"sourceApplicationKeyId"`
+	DestinationApplicationKeyId string        `json:"destinationApplicationKeyId"`
+	ManagedApplicationKeyIds    []interface{} `json:"managedApplicationKeyIds"`
+}
+
+func (s *BucketReplicationOutput) ResourceName() string {
+	return "bucket_replication"
+}
+
+type BucketReplicationInput struct {
+	SourceBucketId              string        `json:"sourceBucketId"`
+	DestinationBucketId         string        `json:"destinationBucketId,omitempty"`
+	ReplicationRuleName         string        `json:"replicationRuleName"`
+	FileNamePrefix              string        `json:"fileNamePrefix,omitempty"`
+	Priority                    int           `json:"priority,omitempty"`
+	IncludeExistingFiles        bool          `json:"includeExistingFiles,omitempty"`
+	IsEnabled                   bool          `json:"isEnabled,omitempty"`
+	SourceApplicationKeyId      string        `json:"sourceApplicationKeyId,omitempty"`
+	DestinationApplicationKeyId string        `json:"destinationApplicationKeyId,omitempty"`
+	ManagedApplicationKeyIds    []interface{} `json:"managedApplicationKeyIds,omitempty"`
+}
+
+func (s *BucketReplicationInput) ResourceName() string {
+	return "bucket_replication"
+}
+
 // BucketFile
 
 type BucketFileInput struct {
-	BucketId     string `json:"bucketId"`
-	FileName     string `json:"fileName"`
-	ShowVersions bool   `json:"showVersions"`
+	BucketId       string `json:"bucketId"`
+	FileName       string `json:"fileName"`
+	ShowVersions   bool   `json:"showVersions"`
+	FileId         string `json:"fileId"`
+	AtTime         string `json:"atTime"`
+	AtTimestamp    int    `json:"atTimestamp"`
+	IncludeHidden  bool   `json:"includeHidden"`
+	FailIfNotFound bool   `json:"failIfNotFound"`
 }
 
 func (s *BucketFileInput) ResourceName() string {
@@ -221,37 +423,222 @@ func (s *BucketFileInput) ResourceName() string {
 
 type BucketFileOutput struct {
 	BucketFileInput
-	Sha1         string        `json:"_sha1"`
-	FileVersions []FileVersion `json:"fileVersions"`
+	Sha1                  string                `json:"_sha1"`
+	Exists                bool                  `json:"exists"`
+	FileVersions          []FileVersion         `json:"fileVersions"`
+	SelectedVersion       *FileVersion          `json:"selectedVersion"`
+	Action                string                `json:"action"`
+	ContentMd5            string                `json:"contentMd5"`
+	ContentSha1           string                `json:"contentSha1"`
+	ContentType           string                `json:"contentType"`
+	FileInfo              map[string]string     `json:"fileInfo"`
+	FileRetention         *FileRetention        `json:"fileRetention"`
+	FileRetentionReadable bool                  `json:"fileRetentionReadable"`
+	LegalHold             string                `json:"legalHold"`
+	LegalHoldReadable     bool                  `json:"legalHoldReadable"`
+	ServerSideEncryption  *ServerSideEncryption `json:"serverSideEncryption"`
+	Size                  int                   `json:"size"`
+	UploadTimestamp       int                   `json:"uploadTimestamp"`
+	ReplicationStatus     string                `json:"replicationStatus"`
+	SseCKeyId             string                `json:"sseCKeyId"`
+	SrcLastModifiedMillis int                   `json:"srcLastModifiedMillis"`
+	LargeFileSha1         string                `json:"largeFileSha1"`
 }
 
 func (s *BucketFileOutput) ResourceName() string {
 	return "bucket_file"
 }
 
+// Buckets
+
+type BucketsInput struct {
+	NamePrefix            string                 `json:"namePrefix"`
+	NameRegex             string                 `json:"nameRegex"`
+	BucketType            string                 `json:"bucketType"`
+	BucketInfo            map[string]interface{} `json:"bucketInfo"`
+	FileLockEnabled       bool                   `json:"fileLockEnabled"`
+	DefaultEncryptionMode string                 `json:"defaultEncryptionMode"`
+}
+
+func (s *BucketsInput) ResourceName() string {
+	return "buckets"
+}
+
+type BucketsBucket struct {
+	AccountId                   string                    `json:"accountId"`
+	BucketId                    string                    `json:"bucketId"`
+	BucketInfo                  map[string]string         `json:"bucketInfo"`
+	BucketName                  string                    `json:"bucketName"`
+	BucketType                  string                    `json:"bucketType"`
+	CorsRules                   []CorsRule                `json:"corsRules"`
+	DefaultServerSideEncryption *ServerSideEncryption     `json:"defaultServerSideEncryption"`
+	FileLockConfiguration       *FileLockConfiguration    `json:"fileLockConfiguration"`
+	LifecycleRules              []LifecycleRule           `json:"lifecycleRules"`
+	Options                     []string                  `json:"options"`
+	ReplicationConfiguration    *ReplicationConfiguration `json:"replicationConfiguration"`
+	Revision                    int                       `json:"revision"`
+}
+
+type BucketsOutput struct {
+	BucketsInput
+	Sha1            string            `json:"_sha1"`
+	Buckets         []BucketsBucket   `json:"buckets"`
+	BucketIdsByName map[string]string `json:"bucketIdsByName"`
+}
+
+func (s *BucketsOutput) ResourceName() string {
+	return "buckets"
+}
+
 // BucketFiles
 
 type BucketFilesInput struct {
-	BucketId     string `json:"bucketId"`
-	FolderName   string `json:"folderName"`
-	ShowVersions bool   `json:"showVersions"`
-	Recursive    bool   `json:"recursive"`
+	BucketId       string        `json:"bucketId"`
+	FolderName     string        `json:"folderName"`
+	ShowVersions   bool          `json:"showVersions"`
+	Recursive      bool          `json:"recursive"`
+	IncludeGlob    string        `json:"includeGlob"`
+	ExcludeGlob    string        `json:"excludeGlob"`
+	IncludeRegex   string        `json:"includeRegex"`
+	ExcludeRegex   string        `json:"excludeRegex"`
+	MinSize        int           `json:"minSize"`
+	MaxSize        int           `json:"maxSize"`
+	UploadedAfter  string        `json:"uploadedAfter"`
+	UploadedBefore string        `json:"uploadedBefore"`
+	Actions        []interface{} `json:"actions"`
+	StartFileName  string        `json:"startFileName"`
+	StartFileId    string        `json:"startFileId"`
+	MaxResults     int           `json:"maxResults"`
+	SortBy         string        `json:"sortBy"`
+	SortDescending bool          `json:"sortDescending"`
+	NamesOnly      bool          `json:"namesOnly"`
+	FilterMaxSize  bool          `json:"filterMaxSize"`
 }
 
 func (s *BucketFilesInput) ResourceName() string {
 	return "bucket_files"
 }
 
+type BucketFilesEntry struct {
+	FileVersion
+	FolderName string `json:"folderName"`
+}
+
 type BucketFilesOutput struct {
 	BucketFilesInput
-	Sha1         string        `json:"_sha1"`
-	FileVersions []FileVersion `json:"fileVersions"`
+	Sha1         string             `json:"_sha1"`
+	Entries      []BucketFilesEntry `json:"entries"`
+	FileVersions []FileVersion      `json:"fileVersions"`
+	FileNames    []string           `json:"fileNames"`
+	Sha1ByName   map[string]string  `json:"sha1ByName"`
+	Folders      []string           `json:"folders"`
+	NextFileName string             `json:"nextFileName"`
+	NextFileId   string             `json:"nextFileId"`
+	TotalCount   int                `json:"totalCount"`
+	TotalSize    int                `json:"totalSize"`
 }
 
 func (s *BucketFilesOutput) ResourceName() string {
 	return "bucket_files"
 }
 
+// BucketUsage
+
+type BucketUsageInput struct {
+	BucketId      string  `json:"bucketId"`
+	FolderName    string  `json:"folderName"`
+	GroupByFolder bool    `json:"groupByFolder"`
+	PricePerGb    float64 `json:"pricePerGb"`
+}
+
+func (s *BucketUsageInput) ResourceName() string {
+	return "bucket_usage"
+}
+
+type BucketUsageTotals struct {
+	TotalBytes               int     `json:"totalBytes"`
+	FileCount                int     `json:"fileCount"`
+	VersionCount             int     `json:"versionCount"`
+	HiddenBytes              int     `json:"hiddenBytes"`
+	UnfinishedLargeFileBytes int     `json:"unfinishedLargeFileBytes"`
+	UnfinishedLargeFileCount int     `json:"unfinishedLargeFileCount"`
+	EstimatedMonthlyCost     float64 `json:"estimatedMonthlyCost"`
+}
+
+type BucketUsageFolder struct {
+	FolderName string `json:"folderName"`
+	BucketUsageTotals
+}
+
+type BucketUsageOutput struct {
+	BucketUsageInput
+	BucketUsageTotals
+	Sha1    string              `json:"_sha1"`
+	Folders []BucketUsageFolder `json:"folders"`
+}
+
+func (s *BucketUsageOutput) ResourceName() string {
+	return "bucket_usage"
+}
+
+// BucketUnfinishedLargeFiles
+
+type UnfinishedLargeFile struct {
+	FileId          string            `json:"fileId"`
+	FileName        string            `json:"fileName"`
+	ContentType     string            `json:"contentType"`
+	FileInfo        map[string]string `json:"fileInfo"`
+	UploadTimestamp int               `json:"uploadTimestamp"`
+	StartTime       string            `json:"startTime"`
+	PartCount       int               `json:"partCount"`
+	UploadedBytes   int               `json:"uploadedBytes"`
+}
+
+type BucketUnfinishedLargeFilesInput struct {
+	BucketId      string `json:"bucketId"`
+	NamePrefix    string `json:"namePrefix"`
+	OlderThan     string `json:"olderThan"`
+	StartedBefore int    `json:"startedBefore"`
+}
+
+func (s *BucketUnfinishedLargeFilesInput) ResourceName() string {
+	return "bucket_unfinished_large_files"
+}
+
+type BucketUnfinishedLargeFilesOutput struct {
+	BucketUnfinishedLargeFilesInput
+	Sha1  string                `json:"_sha1"`
+	Files []UnfinishedLargeFile `json:"files"`
+}
+
+func (s *BucketUnfinishedLargeFilesOutput) ResourceName() string {
+	return "bucket_unfinished_large_files"
+}
+
+// BucketUnfinishedLargeFilesCleanup
+
+type BucketUnfinishedLargeFilesCleanupInput struct {
+	BucketId      string                 `json:"bucketId"`
+	NamePrefix    string                 `json:"namePrefix"`
+	OlderThan     string                 `json:"olderThan"`
+	Keepers       map[string]interface{} `json:"keepers"`
+	StartedBefore int                    `json:"startedBefore"`
+}
+
+func (s *BucketUnfinishedLargeFilesCleanupInput) ResourceName() string {
+	return "bucket_unfinished_large_files_cleanup"
+}
+
+type BucketUnfinishedLargeFilesCleanupOutput struct {
+	BucketUnfinishedLargeFilesCleanupInput
+	Sha1          string                `json:"_sha1"`
+	CanceledFiles []UnfinishedLargeFile `json:"canceledFiles"`
+}
+
+func (s *BucketUnfinishedLargeFilesCleanupOutput) ResourceName() string {
+	return "bucket_unfinished_large_files_cleanup"
+}
+
 // BucketFileSignedUrl
 
 type BucketFileSignedUrlInput struct {
@@ -273,21 +660,65 @@ func (s *BucketFileSignedUrlOutput) ResourceName() string {
 	return "bucket_file_signed_url"
 }
 
+// BucketFileContent
+
+type BucketFileContentInput struct {
+	BucketId   string `json:"bucketId"`
+	FileName   string `json:"fileName"`
+	FileId     string `json:"fileId"`
+	SseCKeyB64 string `json:"sseCKeyB64"`
+	ByteRange  []int  `json:"byteRange"`
+	MaxSize    int    `json:"maxSize"`
+	OutputPath string `json:"outputPath"`
+}
+
+func (s *BucketFileContentInput) ResourceName() string {
+	return "bucket_file_content"
+}
+
+type BucketFileContentOutput struct {
+	BucketFileContentInput
+	RangeStart      int               `json:"rangeStart"`
+	RangeEnd        int               `json:"rangeEnd"`
+	Content         string            `json:"content"`
+	ContentBase64   string            `json:"contentBase64"`
+	ContentLength   int               `json:"contentLength"`
+	ContentSha1     string            `json:"contentSha1"`
+	ContentType     string            `json:"contentType"`
+	FileInfo        map[string]string `json:"fileInfo"`
+	Size            int               `json:"size"`
+	UploadTimestamp int               `json:"uploadTimestamp"`
+}
+
+func (s *BucketFileContentOutput) ResourceName() string {
+	return "bucket_file_content"
+}
+
 // BucketFileVersion
 
 type BucketFileVersionOutput struct {
-	Action               string                  `json:"action"`
-	BucketId             string                  `json:"bucketId"`
-	ContentMd5           string                  `json:"contentMd5"`
-	ContentSha1          string                  `json:"contentSha1"`
-	ContentType          string                  `json:"contentType"`
-	FileId               string                  `json:"fileId"`
-	FileInfo             map[string]string       `json:"fileInfo"`
-	FileName             string                  `json:"fileName"`
-	ServerSideEncryption *ResourceFileEncryption `json:"serverSideEncryption"`
-	Size                 int                     `json:"size"`
-	Source               string                  `json:"source"`
-	UploadTimestamp      int                     `json:"uploadTimestamp"`
+	Action                string                  `json:"action"`
+	BucketId              string                  `json:"bucketId"`
+	ContentMd5            string                  `json:"contentMd5"`
+	ContentSha1           string                  `json:"contentSha1"`
+	ContentType           string                  `json:"contentType"`
+	FileId                string                  `json:"fileId"`
+	FileInfo              map[string]string       `json:"fileInfo"`
+	FileName              string                  `json:"fileName"`
+	FileRetention         *FileRetention          `json:"fileRetention"`
+	FileRetentionReadable bool                    `json:"fileRetentionReadable"`
+	LegalHold             string                  `json:"legalHold"`
+	LegalHoldReadable     bool                    `json:"legalHoldReadable"`
+	ServerSideEncryption  *ResourceFileEncryption `json:"serverSideEncryption"`
+	Size                  int                     `json:"size"`
+	Source                string                  `json:"source"`
+	UploadTimestamp       int                     `json:"uploadTimestamp"`
+	ReplicationStatus     string                  `json:"replicationStatus"`
+	SseCKeyId             string                  `json:"sseCKeyId"`
+	SrcLastModifiedMillis int                     `json:"srcLastModifiedMillis"`
+	LargeFileSha1         string                  `json:"largeFileSha1"`
+	DestroyMode           string                  `json:"destroyMode"`
+	BypassGovernance      bool                    `json:"bypassGovernance"`
 }
 
 func (s *BucketFileVersionOutput) ResourceName() string {
@@ -301,18 +732,95 @@ type BucketFileVersionInput struct {
 	ContentType          string                 `json:"contentType,omitempty"`
 	FileInfo             map[string]interface{} `json:"fileInfo,omitempty"`
 	ServerSideEncryption []interface{}          `json:"serverSideEncryption,omitempty"`
+	FileRetention        []interface{}          `json:"fileRetention,omitempty"`
+	LegalHold            string                 `json:"legalHold,omitempty"`
 	Source               string                 `json:"source,omitempty"`
+	DestroyMode          string                 `json:"destroyMode,omitempty"`
+	BypassGovernance     bool                   `json:"bypassGovernance,omitempty"`
 }
 
 func (s *BucketFileVersionInput) ResourceName() string {
 	return "bucket_file_version"
 }
 
+// BucketFileHide
+
+type BucketFileHideOutput struct {
+	Action          string `json:"action"`
+	BucketId        string `json:"bucketId"`
+	FileId          string `json:"fileId"`
+	FileName        string `json:"fileName"`
+	UploadTimestamp int    `json:"uploadTimestamp"`
+}
+
+func (s *BucketFileHideOutput) ResourceName() string {
+	return "bucket_file_hide"
+}
+
+type BucketFileHideInput struct {
+	FileId   string `json:"fileId,omitempty"`
+	BucketId string `json:"bucketId,omitempty"`
+	FileName string `json:"fileName,omitempty"`
+}
+
+func (s *BucketFileHideInput) ResourceName() string {
+	return "bucket_file_hide"
+}
+
+// BucketFileRetention
+
+type BucketFileRetentionOutput struct {
+	FileId               string `json:"fileId"`
+	FileName             string `json:"fileName"`
+	Mode                 string `json:"mode"`
+	RetainUntilTimestamp int    `json:"retainUntilTimestamp"`
+	BypassGovernance     bool   `json:"bypassGovernance"`
+}
+
+func (s *BucketFileRetentionOutput) ResourceName() string {
+	return "bucket_file_retention"
+}
+
+type BucketFileRetentionInput struct {
+	FileId               string `json:"fileId"`
+	Mode                 string `json:"mode,omitempty"`
+	RetainUntilTimestamp int    `json:"retainUntilTimestamp,omitempty"`
+	BypassGovernance     bool   `json:"bypassGovernance,omitempty"`
+}
+
+func (s *BucketFileRetentionInput) ResourceName() string {
+	return "bucket_file_retention"
+}
+
+// BucketFileLegalHold
+
+type BucketFileLegalHoldOutput struct {
+	FileId    string `json:"fileId"`
+	FileName  string `json:"fileName"`
+	LegalHold string `json:"legalHold"`
+}
+
+func (s *BucketFileLegalHoldOutput) ResourceName() string {
+	return "bucket_file_legal_hold"
+}
+
+type BucketFileLegalHoldInput struct {
+	FileId    string `json:"fileId"`
+	LegalHold string `json:"legalHold,omitempty"`
+}
+
+func (s *BucketFileLegalHoldInput) ResourceName() string {
+	return "bucket_file_legal_hold"
+}
+
 // BucketNotificationRules
 
 type BucketNotificationRulesOutput struct {
-	BucketId          string             `json:"bucketId"`
-	NotificationRules []NotificationRule `json:"notificationRules"`
+	BucketId                string             `json:"bucketId"`
+	Exclusive               bool               `json:"exclusive"`
+	NotificationRules       []NotificationRule `json:"notificationRules"`
+	ResumeSuspended         bool               `json:"resumeSuspended"`
+	GeneratedSigningSecrets map[string]string  `json:"generatedSigningSecrets"`
 }
 
 func (s *BucketNotificationRulesOutput) ResourceName() string {
@@ -320,8 +828,10 @@ func (s *BucketNotificationRulesOutput) ResourceName() string {
 }
 
 type BucketNotificationRulesInput struct {
-	BucketId          string        `json:"bucketId"`
-	NotificationRules []interface{} `json:"notificationRules,omitempty"`
+	BucketId                  string        `json:"bucketId"`
+	NotificationRules         []interface{} `json:"notificationRules,omitempty"`
+	Exclusive                 bool          `json:"exclusive,omitempty"`
+	PreviousNotificationRules []interface{} `json:"previousNotificationRules,omitempty"`
 }
 
 func (s *BucketNotificationRulesInput) ResourceName() string {
@@ -0,0 +1,107 @@
+//####################################################################
+//
+// File: b2/pgp.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+const keybaseLookupUrl = "https://keybase.io/_/api/1.0/user/lookup.json"
+
+// retrievePgpKey returns the PGP entity for an armored public key,
+// or for "keybase:<username>" the primary public key of the Keybase user.
+func retrievePgpKey(ctx context.Context, pgpKey string) (*openpgp.Entity, error) {
+	armoredKey := pgpKey
+	if username, ok := strings.CutPrefix(pgpKey, "keybase:"); ok {
+		var err error
+		armoredKey, err = fetchKeybasePublicKey(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armoredKey))
+	if err != nil {
+		return nil, fmt.Errorf("error reading PGP key: %w", err)
+	}
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("expected one PGP key, got %d", len(entities))
+	}
+
+	return entities[0], nil
+}
+
+func fetchKeybasePublicKey(ctx context.Context, username string) (string, error) {
+	query := url.Values{}
+	query.Set("usernames", username)
+	query.Set("fields", "public_keys")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, keybaseLookupUrl+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error looking up Keybase user %s: %w", username, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error looking up Keybase user %s: %s", username, resp.Status)
+	}
+
+	var lookup struct {
+		Them []struct {
+			PublicKeys struct {
+				Primary struct {
+					Bundle string `json:"bundle"`
+				} `json:"primary"`
+			} `json:"public_keys"`
+		} `json:"them"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&lookup); err != nil {
+		return "", fmt.Errorf("error reading Keybase lookup of user %s: %w", username, err)
+	}
+	if len(lookup.Them) != 1 || lookup.Them[0].PublicKeys.Primary.Bundle == "" {
+		return "", fmt.Errorf("no public key found for Keybase user %s", username)
+	}
+
+	return lookup.Them[0].PublicKeys.Primary.Bundle, nil
+}
+
+// encryptWithPgpKey encrypts the value for the PGP entity, and returns
+// the base64-encoded message together with the fingerprint of the key.
+func encryptWithPgpKey(entity *openpgp.Entity, value string) (string, string, error) {
+	var buf bytes.Buffer
+	w, err := openpgp.Encrypt(&buf, []*openpgp.Entity{entity}, nil, nil, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("error encrypting with PGP key: %w", err)
+	}
+	if _, err := w.Write([]byte(value)); err != nil {
+		return "", "", fmt.Errorf("error encrypting with PGP key: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", "", fmt.Errorf("error encrypting with PGP key: %w", err)
+	}
+
+	fingerprint := hex.EncodeToString(entity.PrimaryKey.Fingerprint)
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), fingerprint, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func init() {
@@ -110,21 +111,41 @@ func New(version string, exec string) func() *schema.Provider {
 					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("B2_ENDPOINT", "production"),
 				},
+				"key_expiry_warning_days": {
+					Description: "Warn when an application key managed by Terraform, or the key used by the provider, expires within the given number of days (B2_KEY_EXPIRY_WARNING_DAYS env)." +
+						" The value 0 disables the warnings.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("B2_KEY_EXPIRY_WARNING_DAYS", 0),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"b2_account_info":              dataSourceB2AccountInfo(),
-				"b2_application_key":           dataSourceB2ApplicationKey(),
-				"b2_bucket":                    dataSourceB2Bucket(),
-				"b2_bucket_file":               dataSourceB2BucketFile(),
-				"b2_bucket_file_signed_url":    dataSourceB2BucketFileSignedUrl(),
-				"b2_bucket_files":              dataSourceB2BucketFiles(),
-				"b2_bucket_notification_rules": dataSourceB2BucketNotificationRules(),
+				"b2_account_info":                  dataSourceB2AccountInfo(),
+				"b2_application_key":               dataSourceB2ApplicationKey(),
+				"b2_application_keys":              dataSourceB2ApplicationKeys(),
+				"b2_bucket":                        dataSourceB2Bucket(),
+				"b2_bucket_file":                   dataSourceB2BucketFile(),
+				"b2_bucket_file_content":           dataSourceB2BucketFileContent(),
+				"b2_bucket_file_signed_url":        dataSourceB2BucketFileSignedUrl(),
+				"b2_bucket_files":                  dataSourceB2BucketFiles(),
+				"b2_bucket_notification_rules":     dataSourceB2BucketNotificationRules(),
+				"b2_bucket_unfinished_large_files": dataSourceB2BucketUnfinishedLargeFiles(),
+				"b2_bucket_usage":                  dataSourceB2BucketUsage(),
+				"b2_buckets":                       dataSourceB2Buckets(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"b2_application_key":           resourceB2ApplicationKey(),
-				"b2_bucket":                    resourceB2Bucket(),
-				"b2_bucket_file_version":       resourceB2BucketFileVersion(),
-				"b2_bucket_notification_rules": resourceB2BucketNotificationRules(),
+				"b2_application_key":                       resourceB2ApplicationKey(),
+				"b2_bucket":                                resourceB2Bucket(),
+				"b2_bucket_cors_rule":                      resourceB2BucketCorsRule(),
+				"b2_bucket_file_hide":                      resourceB2BucketFileHide(),
+				"b2_bucket_file_legal_hold":                resourceB2BucketFileLegalHold(),
+				"b2_bucket_file_retention":                 resourceB2BucketFileRetention(),
+				"b2_bucket_file_version":                   resourceB2BucketFileVersion(),
+				"b2_bucket_lifecycle_rule":                 resourceB2BucketLifecycleRule(),
+				"b2_bucket_notification_rules":             resourceB2BucketNotificationRules(),
+				"b2_bucket_replication":                    resourceB2BucketReplication(),
+				"b2_bucket_unfinished_large_files_cleanup": resourceB2BucketUnfinishedLargeFilesCleanup(),
 			},
 		}
 
@@ -138,13 +159,14 @@ func configure(version string, exec string, p *schema.Provider) func(context.Con
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		userAgent := p.UserAgent("Terraform-B2-Provider", version)
 		client := &Client{
-			Exec:             exec,
-			UserAgentAppend:  userAgent,
-			ApplicationKeyId: d.Get("application_key_id").(string),
-			ApplicationKey:   d.Get("application_key").(string),
-			Endpoint:         d.Get("endpoint").(string),
-			DataSourcesMap:   p.DataSourcesMap,
-			ResourcesMap:     p.ResourcesMap,
+			Exec:                 exec,
+			UserAgentAppend:      userAgent,
+			ApplicationKeyId:     d.Get("application_key_id").(string),
+			ApplicationKey:       d.Get("application_key").(string),
+			Endpoint:             d.Get("endpoint").(string),
+			KeyExpiryWarningDays: d.Get("key_expiry_warning_days").(int),
+			DataSourcesMap:       p.DataSourcesMap,
+			ResourcesMap:         p.ResourcesMap,
 		}
 
 		tflog.Info(ctx, "User Agent append", map[string]interface{}{
@@ -11,12 +11,16 @@
 package b2
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // providerFactories are used to instantiate a provider during acceptance testing.
@@ -91,3 +95,128 @@ func createTempFileTruncate(t *testing.T, size int64) string {
 
 	return filepath.ToSlash(tmpFile.Name())
 }
+
+// testAccClient returns a client for calling the bindings directly, with the credentials of the acceptance tests.
+func testAccClient() (*Client, error) {
+	pybindings, err := GetBindings()
+	if err != nil {
+		return nil, err
+	}
+	endpoint := os.Getenv("B2_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "production"
+	}
+	p := New("test", pybindings)()
+	return &Client{
+		Exec:             pybindings,
+		ApplicationKeyId: os.Getenv("B2_TEST_APPLICATION_KEY_ID"),
+		ApplicationKey:   os.Getenv("B2_TEST_APPLICATION_KEY"),
+		Endpoint:         endpoint,
+		DataSourcesMap:   p.DataSourcesMap,
+		ResourcesMap:     p.ResourcesMap,
+	}, nil
+}
+
+// bucketRulesOutput keeps the rules of a bucket as returned by the API, with all their keys.
+type bucketRulesOutput struct {
+	CorsRules      []map[string]interface{} `json:"corsRules"`
+	LifecycleRules []map[string]interface{} `json:"lifecycleRules"`
+}
+
+func (s *bucketRulesOutput) ResourceName() string {
+	return "bucket"
+}
+
+// testAccCheckBucketRuleKeys checks that the CORS rules ("corsRules") or lifecycle rules ("lifecycleRules")
+// stored in the bucket have no other keys than the given ones.
+func testAccCheckBucketRuleKeys(bucketResourceName string, rulesKey string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[bucketResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", bucketResourceName)
+		}
+		client, err := testAccClient()
+		if err != nil {
+			return err
+		}
+
+		input := BucketInput{
+			BucketId:       rs.Primary.ID,
+			FailIfNotFound: true,
+		}
+		var output bucketRulesOutput
+		err = client.Apply(context.Background(), OpDataSourceRead, &input, &output)
+		if err != nil {
+			return err
+		}
+
+		allowedKeys := map[string]bool{}
+		for _, key := range keys {
+			allowedKeys[key] = true
+		}
+		rules := map[string][]map[string]interface{}{
+			"corsRules":      output.CorsRules,
+			"lifecycleRules": output.LifecycleRules,
+		}[rulesKey]
+		for _, rule := range rules {
+			for key := range rule {
+				if !allowedKeys[key] {
+					return fmt.Errorf("unexpected key %q in %s of %s: %v", key, rulesKey, bucketResourceName, rule)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// testFixturesInput is the input of the test fixtures of the bindings, which prepare the B2 state the tests need.
+type testFixturesInput struct {
+	BucketName string `json:"bucketName"`
+	FileName   string `json:"fileName"`
+	PartCount  int    `json:"partCount"`
+	PartSize   int    `json:"partSize"`
+}
+
+func (s *testFixturesInput) ResourceName() string {
+	return "test_fixtures"
+}
+
+// testAccLargeFilePartSize is the minimum size of the parts of a large file.
+const testAccLargeFilePartSize = 5000000
+
+// testAccStartLargeFile starts a large file in the bucket and uploads the given number of parts,
+// leaving the large file unfinished.
+func testAccStartLargeFile(t *testing.T, bucketName string, fileName string, partCount int) {
+	client, err := testAccClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := testFixturesInput{
+		BucketName: bucketName,
+		FileName:   fileName,
+		PartCount:  partCount,
+		PartSize:   testAccLargeFilePartSize,
+	}
+	err = client.Apply(context.Background(), "start_large_file", &input, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testAccDeleteFileVersions deletes all the file versions in the bucket, including the hide markers,
+// for example the ones left behind by b2_bucket_file_version resources destroyed in the "hide" mode.
+func testAccDeleteFileVersions(t *testing.T, bucketName string) {
+	client, err := testAccClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := testFixturesInput{
+		BucketName: bucketName,
+	}
+	err = client.Apply(context.Background(), "delete_file_versions", &input, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
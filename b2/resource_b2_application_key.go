@@ -12,7 +12,13 @@ package b2
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,24 +27,48 @@ import (
 
 func resourceB2ApplicationKey() *schema.Resource {
 	return &schema.Resource{
-		Description: "B2 application key resource.",
+		Description: "B2 application key resource. B2 keys cannot be updated, so any change replaces the key;" +
+			" use the `create_before_destroy` lifecycle setting to create the new key before the old one is deleted." +
+			" The resource cannot default to `create_before_destroy` by itself, so set it together with `rotation_period`," +
+			" `rotate_before_expiry` or `keepers`; a warning reminds of it at plan time." +
+			" The key used by the provider itself is not deleted unless `allow_self_delete` is set." +
+			" To import the secret of the key together with it, import `<application_key_id>:<application_key>`," +
+			" or set the `B2_IMPORT_APPLICATION_KEY` environment variable; the pair is verified by authorizing with it." +
+			" An import cannot read `pgp_key` from the configuration, so set the `B2_IMPORT_PGP_KEY` environment variable" +
+			" to the same value for a key that uses it; the secret is then stored encrypted, and the key is not replaced" +
+			" on the next apply.",
 
 		CreateContext: resourceB2ApplicationKeyCreate,
 		ReadContext:   resourceB2ApplicationKeyRead,
+		UpdateContext: resourceB2ApplicationKeyUpdate,
 		DeleteContext: resourceB2ApplicationKeyDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceB2ApplicationKeyImport,
 		},
 
+		CustomizeDiff: resourceB2ApplicationKeyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"capabilities": {
 				Description: "A set of strings, each one naming a capability the key has.",
 				Type:        schema.TypeSet,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(applicationKeyCapabilities, false),
 				},
-				Required: true,
-				ForceNew: true,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"capabilities", "capability_preset"},
+			},
+			"capability_preset": {
+				Description: "A predefined set of capabilities given to the key: `read_only`, `read_write`, `upload_only`," +
+					" `bucket_admin`, or `admin` for all the capabilities.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(capabilityPresetNames(), false),
+				ExactlyOneOf: []string{"capabilities", "capability_preset"},
 			},
 			"key_name": {
 				Description:  "The name of the key.",
@@ -55,7 +85,7 @@ func resourceB2ApplicationKey() *schema.Resource {
 				},
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"bucket_id"},
+				ConflictsWith: []string{"bucket_id", "bucket_names"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// Suppress diff if bucket_id is set in config (backward compatibility)
 					if _, ok := d.GetOk("bucket_id"); ok {
@@ -64,6 +94,27 @@ func resourceB2ApplicationKey() *schema.Resource {
 					return false
 				},
 			},
+			"bucket_names": {
+				Description: "When provided, the new key can only access the buckets with the specified names." +
+					" The names are resolved to bucket IDs, stored in `resolved_bucket_ids`, when the key is created;" +
+					" a bucket recreated under the same name replaces the key.",
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"bucket_id", "bucket_ids"},
+			},
+			"resolved_bucket_ids": {
+				Description: "The IDs of the buckets that `bucket_names` resolved to when the key was created.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
 			"name_prefix": {
 				Description: "When present, restricts access to files whose names start with the prefix.",
 				Type:        schema.TypeString,
@@ -71,11 +122,34 @@ func resourceB2ApplicationKey() *schema.Resource {
 				ForceNew:    true,
 			},
 			"application_key": {
-				Description: "The key.",
+				Description: "The key. It is empty when `pgp_key` is set.",
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"pgp_key": {
+				Description: "When provided, the key is encrypted with the given armored PGP public key, or the public key of" +
+					" a Keybase user given as `keybase:<username>`, and only stored encrypted, in `encrypted_application_key`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// The key given in B2_IMPORT_PGP_KEY may lack the final newline of a heredoc
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+			"encrypted_application_key": {
+				Description: "When `pgp_key` is set, the base64-encoded PGP message containing the key." +
+					" It can be decrypted with e.g. `terraform output -raw encrypted_key | base64 -d | gpg --decrypt`.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_fingerprint": {
+				Description: "When `pgp_key` is set, the fingerprint of the PGP key used to encrypt the key.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"application_key_id": {
 				Description: "The ID of the newly created key.",
 				Type:        schema.TypeString,
@@ -94,19 +168,67 @@ func resourceB2ApplicationKey() *schema.Resource {
 				},
 				Computed: true,
 			},
+			"expiration_time": {
+				Description: "When present, says when this key will expire, as an RFC 3339 timestamp.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"valid_duration_in_seconds": {
-				Description:  "When provided, the key will expire after the given number of seconds, and will have expirationTimestamp set. Value must be a positive integer, and must be less than 1000 days (in seconds).",
-				Type:         schema.TypeInt,
+				Description:   "When provided, the key will expire after the given number of seconds, and will have expirationTimestamp set. Value must be a positive integer, and must be less than 1000 days (in seconds).",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(1, 86400000),
+				ConflictsWith: []string{"valid_until"},
+			},
+			"valid_until": {
+				Description:   "When provided, the key will expire at the given RFC 3339 timestamp, which must be less than 1000 days in the future.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"valid_duration_in_seconds"},
+			},
+			"rotation_period": {
+				Description:  "When provided, the key is replaced once it is older than the given duration, e.g. `720h`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateKeyRotation(validateDuration),
+			},
+			"rotate_before_expiry": {
+				Description:  "When provided, the key is replaced once it expires within the given duration, e.g. `168h`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateKeyRotation(validateDuration),
+			},
+			"rotation_timestamp": {
+				Description: "When `rotation_period` is set, says when this key will be replaced, in milliseconds since 1970.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger the replacement of the key.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.IntBetween(1, 86400000),
+				ValidateFunc: validateKeyRotation(nil),
+			},
+			"allow_self_delete": {
+				Description: "Whether the key can be deleted or replaced when it is the key used by the provider." +
+					" Deleting it makes the rest of the run fail, so it has to be set, and applied, beforehand.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"bucket_id": {
 				Description:   "When present, restricts access to one bucket.",
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"bucket_ids"},
+				ConflictsWith: []string{"bucket_ids", "bucket_names"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// Suppress diff if bucket_ids is set (bucket_id is auto-populated from bucket_ids)
 					if _, ok := d.GetOk("bucket_ids"); ok {
@@ -128,15 +250,37 @@ func resourceB2ApplicationKeyCreate(ctx context.Context, d *schema.ResourceData,
 		Capabilities:           d.Get("capabilities").(*schema.Set).List(),
 		NamePrefix:             d.Get("name_prefix").(string),
 		BucketIds:              d.Get("bucket_ids").(*schema.Set).List(),
+		BucketNames:            d.Get("bucket_names").(*schema.Set).List(),
 		BucketId:               d.Get("bucket_id").(string), // deprecated
 		ValidDurationInSeconds: d.Get("valid_duration_in_seconds").(int),
 	}
 
+	if validUntil := d.Get("valid_until").(string); validUntil != "" {
+		expiration, err := time.Parse(time.RFC3339, validUntil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		input.ValidDurationInSeconds = int(time.Until(expiration).Seconds())
+		if input.ValidDurationInSeconds < 1 {
+			return diag.Errorf("valid_until must be in the future, got %s", validUntil)
+		}
+	}
+
 	// Deprecated bucket_id requires the B2 Native API v2 path
 	if input.BucketId != "" {
 		input.Apiver = "v2"
 	}
 
+	var pgpEntity *openpgp.Entity
+	if pgpKey := d.Get("pgp_key").(string); pgpKey != "" {
+		// Retrieve the PGP key first, so that no key is created when it is invalid
+		var err error
+		pgpEntity, err = retrievePgpKey(ctx, pgpKey)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var output ApplicationKeyOutput
 	err := client.Apply(ctx, OpResourceCreate, &input, &output)
 	if err != nil {
@@ -145,13 +289,41 @@ func resourceB2ApplicationKeyCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(output.ApplicationKeyId)
 
+	if pgpEntity != nil {
+		output.EncryptedApplicationKey, output.KeyFingerprint, err = encryptWithPgpKey(pgpEntity, output.ApplicationKey)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// The plaintext key is not stored in the state
+		output.ApplicationKey = ""
+	}
+
+	rotationTimestamp := 0
+	if rotationPeriod := d.Get("rotation_period").(string); rotationPeriod != "" {
+		period, _ := time.ParseDuration(rotationPeriod)
+		rotationTimestamp = int(time.Now().Add(period).UnixMilli())
+	}
+
+	// These fields are not returned by the API but are needed for the resource
+	output.ExpirationTime = formatTimestamp(output.ExpirationTimestamp)
+	output.ValidUntil = d.Get("valid_until").(string)
+	output.RotationPeriod = d.Get("rotation_period").(string)
+	output.RotateBeforeExpiry = d.Get("rotate_before_expiry").(string)
+	output.RotationTimestamp = rotationTimestamp
+	output.Keepers = d.Get("keepers").(map[string]interface{})
+	output.AllowSelfDelete = d.Get("allow_self_delete").(bool)
+	output.CapabilityPreset = d.Get("capability_preset").(string)
+	output.BucketNames = input.BucketNames
+	output.PgpKey = d.Get("pgp_key").(string)
+	separateResolvedBucketIds(&output, input.BucketNames)
+
 	err = client.Populate(ctx, OpResourceCreate, &output, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	// Preserve valid_duration_in_seconds in state
-	if err := d.Set("valid_duration_in_seconds", input.ValidDurationInSeconds); err != nil {
+	if err := d.Set("valid_duration_in_seconds", d.Get("valid_duration_in_seconds").(int)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -163,6 +335,7 @@ func resourceB2ApplicationKeyRead(ctx context.Context, d *schema.ResourceData, m
 
 	input := ApplicationKeyInput{
 		ApplicationKeyId: d.Id(),
+		BucketNames:      d.Get("bucket_names").(*schema.Set).List(),
 	}
 
 	var output ApplicationKeyOutput
@@ -180,8 +353,22 @@ func resourceB2ApplicationKeyRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	output.ApplicationKey = d.Get("application_key").(string)
+	output.PgpKey = d.Get("pgp_key").(string)
+	output.EncryptedApplicationKey = d.Get("encrypted_application_key").(string)
+	output.KeyFingerprint = d.Get("key_fingerprint").(string)
 	validDuration := d.Get("valid_duration_in_seconds").(int)
 
+	// These fields are not returned by the API but are needed for the resource
+	output.ExpirationTime = formatTimestamp(output.ExpirationTimestamp)
+	output.ValidUntil = d.Get("valid_until").(string)
+	output.RotationPeriod = d.Get("rotation_period").(string)
+	output.RotateBeforeExpiry = d.Get("rotate_before_expiry").(string)
+	output.RotationTimestamp = d.Get("rotation_timestamp").(int)
+	output.Keepers = d.Get("keepers").(map[string]interface{})
+	output.AllowSelfDelete = d.Get("allow_self_delete").(bool)
+	output.CapabilityPreset = d.Get("capability_preset").(string)
+	separateResolvedBucketIds(&output, input.BucketNames)
+
 	err = client.Populate(ctx, OpResourceRead, &output, d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -192,12 +379,41 @@ func resourceB2ApplicationKeyRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return keyExpiryWarnings(client.KeyExpiryWarningDays, output.ApplicationKeyId, output.ExpirationTimestamp)
+}
+
+func resourceB2ApplicationKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only the rotation settings, which are not stored in B2, can be updated in place
+	if d.HasChange("rotation_period") {
+		oldRotationPeriod, newRotationPeriod := d.GetChange("rotation_period")
+		rotationTimestamp := 0
+		if newRotationPeriod.(string) != "" {
+			period, _ := time.ParseDuration(newRotationPeriod.(string))
+			if oldRotationPeriod.(string) != "" {
+				// Keep counting from the creation of the key
+				oldPeriod, _ := time.ParseDuration(oldRotationPeriod.(string))
+				createdAt := time.UnixMilli(int64(d.Get("rotation_timestamp").(int))).Add(-oldPeriod)
+				rotationTimestamp = int(createdAt.Add(period).UnixMilli())
+			} else {
+				rotationTimestamp = int(time.Now().Add(period).UnixMilli())
+			}
+		}
+		if err := d.Set("rotation_timestamp", rotationTimestamp); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceB2ApplicationKeyRead(ctx, d, meta)
 }
 
 func resourceB2ApplicationKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client)
 
+	if d.Id() == client.ApplicationKeyId && !d.Get("allow_self_delete").(bool) {
+		return diag.Errorf("refusing to delete application key %s, which is used by the provider; "+
+			"set allow_self_delete to true and apply it first to delete it", d.Id())
+	}
+
 	input := ApplicationKeyInput{
 		ApplicationKeyId: d.Id(),
 	}
@@ -211,3 +427,237 @@ func resourceB2ApplicationKeyDelete(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+func resourceB2ApplicationKeyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client)
+
+	applicationKeyId, applicationKey, ok := strings.Cut(d.Id(), ":")
+	if !ok {
+		applicationKey = os.Getenv("B2_IMPORT_APPLICATION_KEY")
+	}
+	if applicationKeyId == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <application_key_id> or <application_key_id>:<application_key>", d.Id())
+	}
+
+	var pgpEntity *openpgp.Entity
+	if pgpKey := os.Getenv("B2_IMPORT_PGP_KEY"); pgpKey != "" {
+		var err error
+		pgpEntity, err = retrievePgpKey(ctx, pgpKey)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Set("pgp_key", pgpKey); err != nil {
+			return nil, err
+		}
+	}
+
+	if applicationKey != "" {
+		input := ApplicationKeyInput{
+			ApplicationKeyId: applicationKeyId,
+			ApplicationKey:   applicationKey,
+		}
+
+		var output ApplicationKeyOutput
+		err := client.Apply(ctx, OpResourceRead, &input, &output)
+		if err != nil {
+			return nil, err
+		}
+		if output.ApplicationKeyId == "" {
+			return nil, fmt.Errorf("application key %s not found", applicationKeyId)
+		}
+
+		if pgpEntity != nil {
+			// The plaintext key is not stored in the state
+			encryptedApplicationKey, keyFingerprint, err := encryptWithPgpKey(pgpEntity, applicationKey)
+			if err != nil {
+				return nil, err
+			}
+			if err := d.Set("encrypted_application_key", encryptedApplicationKey); err != nil {
+				return nil, err
+			}
+			if err := d.Set("key_fingerprint", keyFingerprint); err != nil {
+				return nil, err
+			}
+		} else if err := d.Set("application_key", applicationKey); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(applicationKeyId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceB2ApplicationKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if preset := d.Get("capability_preset").(string); preset != "" {
+		if err := d.SetNew("capabilities", capabilityPresets[preset]); err != nil {
+			return err
+		}
+	}
+	if err := checkApplicationKeyCapabilities(d); err != nil {
+		return err
+	}
+	if err := checkApplicationKeyValidUntil(d); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	now := time.Now()
+	rotate := false
+	if rotationTimestamp := d.Get("rotation_timestamp").(int); rotationTimestamp > 0 && !d.HasChange("rotation_period") {
+		rotate = !now.Before(time.UnixMilli(int64(rotationTimestamp)))
+	}
+	expirationTimestamp := d.Get("expiration_timestamp").(int)
+	if expirationTimestamp > 0 && !now.Before(time.UnixMilli(int64(expirationTimestamp))) {
+		// An expired key is useless, so it is replaced
+		rotate = true
+	}
+	if rotateBeforeExpiry := d.Get("rotate_before_expiry").(string); rotateBeforeExpiry != "" {
+		period, err := time.ParseDuration(rotateBeforeExpiry)
+		if err == nil && expirationTimestamp > 0 && !now.Add(period).Before(time.UnixMilli(int64(expirationTimestamp))) {
+			rotate = true
+		}
+	}
+	if !rotate {
+		return checkApplicationKeySelfReplace(d, meta.(*Client))
+	}
+
+	// Plan the replacement of the key
+	if err := d.SetNewComputed("application_key_id"); err != nil {
+		return err
+	}
+	if err := d.ForceNew("application_key_id"); err != nil {
+		return err
+	}
+	return checkApplicationKeySelfReplace(d, meta.(*Client))
+}
+
+// separateResolvedBucketIds moves the bucket IDs that bucket_names resolved to from
+// bucket_ids, which only holds the IDs given in the configuration, to resolved_bucket_ids.
+func separateResolvedBucketIds(output *ApplicationKeyOutput, bucketNames []interface{}) {
+	if len(bucketNames) == 0 {
+		output.ResolvedBucketIds = []interface{}{}
+		return
+	}
+	output.ResolvedBucketIds = output.BucketIds
+	output.BucketIds = []interface{}{}
+	output.BucketId = ""
+}
+
+// checkApplicationKeyValidUntil fails the plan when valid_until is not within the 1000 days that B2 allows.
+func checkApplicationKeyValidUntil(d *schema.ResourceDiff) error {
+	if !d.HasChange("valid_until") || !d.NewValueKnown("valid_until") {
+		return nil
+	}
+	validUntil := d.Get("valid_until").(string)
+	if validUntil == "" {
+		return nil
+	}
+	expiration, err := time.Parse(time.RFC3339, validUntil)
+	if err != nil {
+		return err
+	}
+	if until := time.Until(expiration); until <= 0 {
+		return fmt.Errorf("valid_until must be in the future, got %s", validUntil)
+	} else if until >= 1000*24*time.Hour {
+		return fmt.Errorf("valid_until must be less than 1000 days in the future, got %s", validUntil)
+	}
+	return nil
+}
+
+// checkApplicationKeyCapabilities fails the plan when the capabilities do not work with the restrictions of the key.
+func checkApplicationKeyCapabilities(d *schema.ResourceDiff) error {
+	restricted := !d.NewValueKnown("bucket_ids") || !d.NewValueKnown("bucket_id") || !d.NewValueKnown("bucket_names") ||
+		d.Get("bucket_ids").(*schema.Set).Len() > 0 || d.Get("bucket_id").(string) != "" ||
+		d.Get("bucket_names").(*schema.Set).Len() > 0
+
+	if restricted {
+		var accountCapabilities []string
+		for _, capability := range d.Get("capabilities").(*schema.Set).List() {
+			if slices.Contains(accountLevelCapabilities, capability.(string)) {
+				accountCapabilities = append(accountCapabilities, capability.(string))
+			}
+		}
+		if len(accountCapabilities) > 0 {
+			slices.Sort(accountCapabilities)
+			return fmt.Errorf("keys restricted to buckets cannot have the account-level capabilities %s",
+				strings.Join(accountCapabilities, ", "))
+		}
+	} else if d.Get("name_prefix").(string) != "" {
+		return fmt.Errorf("name_prefix can only be used by keys restricted to buckets")
+	}
+
+	return nil
+}
+
+// checkApplicationKeySelfReplace fails the plan when it replaces the key used by the provider.
+// Plans that only destroy the key are checked when the key is deleted.
+func checkApplicationKeySelfReplace(d *schema.ResourceDiff, client *Client) error {
+	if d.Id() != client.ApplicationKeyId || d.Get("allow_self_delete").(bool) {
+		return nil
+	}
+
+	replace := d.HasChange("application_key_id")
+	for k, s := range client.ResourcesMap["b2_application_key"].Schema {
+		if s.ForceNew && d.HasChange(k) {
+			replace = true
+		}
+	}
+	if replace {
+		return fmt.Errorf("application key %s is used by the provider and would be replaced; "+
+			"set allow_self_delete to true and apply it first to replace it", d.Id())
+	}
+	return nil
+}
+
+// applicationKeyCapabilities lists the capabilities that can be given to application keys.
+var applicationKeyCapabilities = []string{
+	"listKeys", "writeKeys", "deleteKeys",
+	"listAllBucketNames", "listBuckets", "readBuckets", "writeBuckets", "deleteBuckets",
+	"readBucketEncryption", "writeBucketEncryption",
+	"readBucketRetentions", "writeBucketRetentions",
+	"readBucketReplications", "writeBucketReplications",
+	"readBucketNotifications", "writeBucketNotifications",
+	"readBucketLogging", "writeBucketLogging",
+	"listFiles", "readFiles", "shareFiles", "writeFiles", "deleteFiles",
+	"readFileLegalHolds", "writeFileLegalHolds",
+	"readFileRetentions", "writeFileRetentions", "bypassGovernance",
+}
+
+// accountLevelCapabilities lists the capabilities that keys restricted to buckets cannot have.
+var accountLevelCapabilities = []string{"listKeys", "writeKeys", "deleteKeys"}
+
+var readOnlyCapabilities = []string{
+	"listAllBucketNames", "listBuckets", "readBuckets", "readBucketEncryption", "readBucketRetentions",
+	"listFiles", "readFiles", "shareFiles", "readFileLegalHolds", "readFileRetentions",
+}
+
+var readWriteCapabilities = append(slices.Clone(readOnlyCapabilities),
+	"writeFiles", "deleteFiles", "writeFileLegalHolds", "writeFileRetentions",
+)
+
+// capabilityPresets maps the values of capability_preset to the capabilities they give.
+var capabilityPresets = map[string][]string{
+	"read_only":   readOnlyCapabilities,
+	"read_write":  readWriteCapabilities,
+	"upload_only": {"listBuckets", "writeFiles"},
+	"bucket_admin": append(slices.Clone(readWriteCapabilities),
+		"writeBuckets", "writeBucketEncryption", "writeBucketRetentions",
+		"readBucketReplications", "writeBucketReplications",
+		"readBucketNotifications", "writeBucketNotifications",
+		"readBucketLogging", "writeBucketLogging", "bypassGovernance",
+	),
+	"admin": applicationKeyCapabilities,
+}
+
+func capabilityPresetNames() []string {
+	names := make([]string, 0, len(capabilityPresets))
+	for name := range capabilityPresets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
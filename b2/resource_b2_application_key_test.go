@@ -11,12 +11,21 @@
 package b2
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourceB2ApplicationKey_basic(t *testing.T) {
@@ -38,6 +47,7 @@ func TestAccResourceB2ApplicationKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "capabilities.0", "readFiles"),
 					resource.TestCheckResourceAttr(resourceName, "expiration_timestamp", "0"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_time", ""),
 					resource.TestCheckResourceAttr(resourceName, "key_name", keyName),
 					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
 					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
@@ -51,10 +61,52 @@ func TestAccResourceB2ApplicationKey_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"application_key", "valid_duration_in_seconds"},
 			},
+			{
+				// importing the key together with its secret
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccResourceB2ApplicationKeyImportStateIdWithSecret(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"valid_duration_in_seconds"},
+			},
 		},
 	})
 }
 
+func TestAccResourceB2ApplicationKey_importInvalidSecret(t *testing.T) {
+	resourceName := "b2_application_key.test"
+
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2ApplicationKeyConfig_basic(keyName),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources[resourceName].Primary.ID + ":K000invalidSecret", nil
+				},
+				ExpectError: regexp.MustCompile("Could not authorize with Application Key"),
+			},
+		},
+	})
+}
+
+func testAccResourceB2ApplicationKeyImportStateIdWithSecret(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s:%s", rs.Primary.ID, rs.Primary.Attributes["application_key"]), nil
+	}
+}
+
 func TestAccResourceB2ApplicationKey_all(t *testing.T) {
 	parentResourceName := "b2_bucket.test"
 	resourceName := "b2_application_key.test"
@@ -143,6 +195,259 @@ func TestAccResourceB2ApplicationKey_deprecatedBucketId(t *testing.T) {
 	})
 }
 
+func TestAccResourceB2ApplicationKey_rotation(t *testing.T) {
+	resourceName := "b2_application_key.test"
+
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+	validUntil := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+
+	var applicationKeyId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceB2ApplicationKeyConfig_rotation(keyName, time.Now().Add(1001*24*time.Hour).UTC().Format(time.RFC3339), "24h", "1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("valid_until must be less than 1000 days in the future"),
+			},
+			{
+				Config: testAccResourceB2ApplicationKeyConfig_rotation(keyName, validUntil, "24h", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "valid_until", validUntil),
+					resource.TestCheckResourceAttr(resourceName, "valid_duration_in_seconds", "0"),
+					resource.TestMatchResourceAttr(resourceName, "expiration_timestamp", regexp.MustCompile("^[1-9][0-9]{12,}$")),
+					resource.TestMatchResourceAttr(resourceName, "expiration_time", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`)),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "720h"),
+					resource.TestMatchResourceAttr(resourceName, "rotation_timestamp", regexp.MustCompile("^[1-9][0-9]{12,}$")),
+					resource.TestCheckResourceAttr(resourceName, "keepers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "keepers.version", "1"),
+					resource.TestCheckResourceAttrWith(resourceName, "application_key_id", func(value string) error {
+						applicationKeyId = value
+						return nil
+					}),
+				),
+			},
+			{
+				// changing a keeper replaces the key
+				Config: testAccResourceB2ApplicationKeyConfig_rotation(keyName, validUntil, "24h", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keepers.version", "2"),
+					resource.TestCheckResourceAttrWith(resourceName, "application_key_id", func(value string) error {
+						if value == applicationKeyId {
+							return fmt.Errorf("application key was not replaced")
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				// the key expires within rotate_before_expiry, so it is planned for replacement
+				Config:             testAccResourceB2ApplicationKeyConfig_rotation(keyName, validUntil, "72h", "2"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceB2ApplicationKey_allowSelfDelete(t *testing.T) {
+	resourceName := "b2_application_key.test"
+
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2ApplicationKeyConfig_allowSelfDelete(keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allow_self_delete", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"application_key", "allow_self_delete"},
+			},
+		},
+	})
+}
+
+func TestAccResourceB2ApplicationKey_capabilityPreset(t *testing.T) {
+	resourceName := "b2_application_key.test"
+
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2ApplicationKeyConfig_capabilityPreset(keyName, "upload_only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "capability_preset", "upload_only"),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "listBuckets"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "writeFiles"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2ApplicationKey_invalidCapabilities(t *testing.T) {
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceB2ApplicationKeyConfig_capabilities(keyName, `["readFile"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expected capabilities.0 to be one of"),
+			},
+			{
+				Config:      testAccResourceB2ApplicationKeyConfig_capabilityPreset(keyName, "admin"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("keys restricted to buckets cannot have the account-level capabilities"),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2ApplicationKey_bucketNames(t *testing.T) {
+	resourceName := "b2_application_key.test"
+	parentResourceName := "b2_bucket.test"
+
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2ApplicationKeyConfig_bucketNames(keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "bucket_names.*", parentResourceName, "bucket_name"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "resolved_bucket_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resolved_bucket_ids.*", parentResourceName, "bucket_id"),
+				),
+			},
+			{
+				// recreating the bucket under the same name shows up as drift of the key
+				Taint:              []string{parentResourceName},
+				Config:             testAccResourceB2ApplicationKeyConfig_bucketNames(keyName),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// which is then replaced
+				Config: testAccResourceB2ApplicationKeyConfig_bucketNames(keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resolved_bucket_ids.*", parentResourceName, "bucket_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2ApplicationKey_pgpKey(t *testing.T) {
+	resourceName := "b2_application_key.test"
+
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	entity, err := openpgp.NewEntity("test-b2-tfp", "", "test-b2-tfp@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var armored strings.Builder
+	w, err := armor.Encode(&armored, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var applicationKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2ApplicationKeyConfig_pgpKey(keyName, armored.String()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "application_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "key_fingerprint", hex.EncodeToString(entity.PrimaryKey.Fingerprint)),
+					resource.TestCheckResourceAttrWith(resourceName, "encrypted_application_key", func(value string) error {
+						encrypted, err := base64.StdEncoding.DecodeString(value)
+						if err != nil {
+							return err
+						}
+						md, err := openpgp.ReadMessage(bytes.NewReader(encrypted), openpgp.EntityList{entity}, nil, nil)
+						if err != nil {
+							return err
+						}
+						decrypted, err := io.ReadAll(md.UnverifiedBody)
+						if err != nil {
+							return err
+						}
+						if !regexp.MustCompile("^[\x20-\x7E]{31}$").Match(decrypted) {
+							return fmt.Errorf("unexpected decrypted application key")
+						}
+						applicationKey = string(decrypted)
+						return nil
+					}),
+				),
+			},
+			{
+				// importing the key together with its secret, which is encrypted again
+				PreConfig: func() {
+					t.Setenv("B2_IMPORT_PGP_KEY", armored.String())
+				},
+				Config:       testAccResourceB2ApplicationKeyConfig_pgpKey(keyName, armored.String()),
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources[resourceName].Primary.ID + ":" + applicationKey, nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+					attributes := states[0].Attributes
+					if attributes["application_key"] != "" {
+						return fmt.Errorf("the plaintext application key was stored in the state")
+					}
+					if attributes["encrypted_application_key"] == "" {
+						return fmt.Errorf("the encrypted application key was not stored in the state")
+					}
+					if expected := hex.EncodeToString(entity.PrimaryKey.Fingerprint); attributes["key_fingerprint"] != expected {
+						return fmt.Errorf("expected key_fingerprint %s, got %s", expected, attributes["key_fingerprint"])
+					}
+					return nil
+				},
+				ImportStatePersist: true,
+			},
+			{
+				// the imported key is not replaced
+				Config:   testAccResourceB2ApplicationKeyConfig_pgpKey(keyName, armored.String()),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccResourceB2ApplicationKeyConfig_basic(keyName string) string {
 	return fmt.Sprintf(`
 resource "b2_application_key" "test" {
@@ -185,3 +490,83 @@ resource "b2_application_key" "test" {
 }
 `, keyName, keyName)
 }
+
+func testAccResourceB2ApplicationKeyConfig_rotation(keyName string, validUntil string, rotateBeforeExpiry string, version string) string {
+	return fmt.Sprintf(`
+resource "b2_application_key" "test" {
+  key_name             = "%s"
+  capabilities         = ["readFiles"]
+  valid_until          = "%s"
+  rotation_period      = "720h"
+  rotate_before_expiry = "%s"
+  keepers = {
+    version = "%s"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, keyName, validUntil, rotateBeforeExpiry, version)
+}
+
+func testAccResourceB2ApplicationKeyConfig_allowSelfDelete(keyName string) string {
+	return fmt.Sprintf(`
+resource "b2_application_key" "test" {
+  key_name          = "%s"
+  capabilities      = ["readFiles"]
+  allow_self_delete = true
+}
+`, keyName)
+}
+
+func testAccResourceB2ApplicationKeyConfig_capabilities(keyName string, capabilities string) string {
+	return fmt.Sprintf(`
+resource "b2_application_key" "test" {
+  key_name     = "%s"
+  capabilities = %s
+}
+`, keyName, capabilities)
+}
+
+func testAccResourceB2ApplicationKeyConfig_capabilityPreset(keyName string, capabilityPreset string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_application_key" "test" {
+  key_name          = "%s"
+  capability_preset = "%s"
+  bucket_ids        = [b2_bucket.test.bucket_id]
+}
+`, keyName, keyName, capabilityPreset)
+}
+
+func testAccResourceB2ApplicationKeyConfig_bucketNames(keyName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_application_key" "test" {
+  key_name     = "%s"
+  capabilities = ["readFiles"]
+  bucket_names = [b2_bucket.test.bucket_name]
+}
+`, keyName, keyName)
+}
+
+func testAccResourceB2ApplicationKeyConfig_pgpKey(keyName string, pgpKey string) string {
+	return fmt.Sprintf(`
+resource "b2_application_key" "test" {
+  key_name     = "%s"
+  capabilities = ["readFiles"]
+  pgp_key      = <<EOT
+%s
+EOT
+}
+`, keyName, pgpKey)
+}
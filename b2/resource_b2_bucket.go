@@ -100,6 +100,28 @@ func resourceB2Bucket() *schema.Resource {
 				Elem:        getLifecycleRulesElem(false),
 				Optional:    true,
 			},
+			"replication_configuration": {
+				Description: "Cloud Replication settings of the bucket. When not set, the replication settings are left untouched, so that they can be managed with `b2_bucket_replication` resources.",
+				Type:        schema.TypeList,
+				Elem:        getReplicationConfigurationElem(false),
+				Optional:    true,
+				MaxItems:    1,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Suppress diff if replication_configuration is not set in config
+					v := d.GetRawConfig()
+					if v.IsNull() || !v.IsKnown() {
+						return false
+					}
+					v = v.GetAttr("replication_configuration")
+					return v.IsKnown() && (v.IsNull() || v.LengthInt() == 0)
+				},
+			},
+			"ignore_external_rules": {
+				Description: "Ignore the CORS rules and lifecycle rules of the bucket that are not listed in `cors_rules` and `lifecycle_rules`," +
+					" e.g. the ones managed with `b2_bucket_cors_rule` and `b2_bucket_lifecycle_rule` resources.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"bucket_id": {
 				Description: "The ID of the bucket.",
 				Type:        schema.TypeString,
@@ -138,6 +160,7 @@ func resourceB2BucketCreate(ctx context.Context, d *schema.ResourceData, meta in
 		FileLockConfiguration:       d.Get("file_lock_configuration").([]interface{}),
 		DefaultServerSideEncryption: d.Get("default_server_side_encryption").([]interface{}),
 		LifecycleRules:              d.Get("lifecycle_rules").([]interface{}),
+		ReplicationConfiguration:    d.Get("replication_configuration").([]interface{}),
 	}
 
 	var output BucketOutput
@@ -148,6 +171,9 @@ func resourceB2BucketCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(output.BucketId)
 
+	// This field is not returned by the API but is needed for the resource
+	output.IgnoreExternalRules = d.Get("ignore_external_rules").(bool)
+
 	err = client.Populate(ctx, OpResourceCreate, &output, d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -160,8 +186,10 @@ func resourceB2BucketRead(ctx context.Context, d *schema.ResourceData, meta inte
 	client := meta.(*Client)
 
 	input := BucketInput{
-		BucketId:  d.Id(),
-		CorsRules: d.Get("cors_rules").([]interface{}),
+		BucketId:            d.Id(),
+		CorsRules:           d.Get("cors_rules").([]interface{}),
+		LifecycleRules:      d.Get("lifecycle_rules").([]interface{}),
+		IgnoreExternalRules: d.Get("ignore_external_rules").(bool),
 	}
 
 	var output BucketOutput
@@ -178,6 +206,9 @@ func resourceB2BucketRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return nil
 	}
 
+	// This field is not returned by the API but is needed for the resource
+	output.IgnoreExternalRules = input.IgnoreExternalRules
+
 	err = client.Populate(ctx, OpResourceRead, &output, d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -198,6 +229,18 @@ func resourceB2BucketUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		FileLockConfiguration:       d.Get("file_lock_configuration").([]interface{}),
 		DefaultServerSideEncryption: d.Get("default_server_side_encryption").([]interface{}),
 		LifecycleRules:              d.Get("lifecycle_rules").([]interface{}),
+		IgnoreExternalRules:         d.Get("ignore_external_rules").(bool),
+	}
+	if v := d.GetRawConfig().GetAttr("replication_configuration"); !v.IsNull() && v.LengthInt() > 0 {
+		// The state is kept when the configuration is not set, replication is left untouched then
+		input.ReplicationConfiguration = d.Get("replication_configuration").([]interface{})
+	}
+	if input.IgnoreExternalRules {
+		// Rules removed from the configuration have to be removed from the bucket as well
+		previousCorsRules, _ := d.GetChange("cors_rules")
+		previousLifecycleRules, _ := d.GetChange("lifecycle_rules")
+		input.PreviousCorsRules = previousCorsRules.([]interface{})
+		input.PreviousLifecycleRules = previousLifecycleRules.([]interface{})
 	}
 
 	var output BucketOutput
@@ -206,6 +249,9 @@ func resourceB2BucketUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
+	// This field is not returned by the API but is needed for the resource
+	output.IgnoreExternalRules = input.IgnoreExternalRules
+
 	err = client.Populate(ctx, OpResourceUpdate, &output, d)
 	if err != nil {
 		return diag.FromErr(err)
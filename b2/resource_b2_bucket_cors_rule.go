@@ -0,0 +1,203 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_cors_rule.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceB2BucketCorsRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 bucket CORS rule resource. It manages a single CORS rule of a bucket, leaving the other rules untouched." +
+			" Set `ignore_external_rules` of the `b2_bucket` resource managing the same bucket.",
+
+		CreateContext: resourceB2BucketCorsRuleCreate,
+		ReadContext:   resourceB2BucketCorsRuleRead,
+		UpdateContext: resourceB2BucketCorsRuleUpdate,
+		DeleteContext: resourceB2BucketCorsRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importBucketRuleState("cors_rule_name"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Description:  "The ID of the bucket.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cors_rule_name": {
+				Description:  "A name for humans to recognize the rule in a user interface. It identifies the rule within the bucket.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"allowed_origins": {
+				Description: "A non-empty list specifying which origins the rule covers.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required: true,
+				MinItems: 1,
+			},
+			"allowed_operations": {
+				Description: "A list specifying which operations the rule allows.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required: true,
+				MinItems: 1,
+			},
+			"max_age_seconds": {
+				Description: "This specifies the maximum number of seconds that a browser may cache the response to a preflight request.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"allowed_headers": {
+				Description: "If present, this is a list of headers that are allowed in a pre-flight OPTIONS's request's Access-Control-Request-Headers header value.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"expose_headers": {
+				Description: "If present, this is a list of headers that may be exposed to an application inside the client.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"revision": {
+				Description: "Bucket revision after the last change of the rule.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceB2BucketCorsRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketCorsRuleInput{
+		BucketId:          d.Get("bucket_id").(string),
+		CorsRuleName:      d.Get("cors_rule_name").(string),
+		AllowedOrigins:    d.Get("allowed_origins").([]interface{}),
+		AllowedOperations: d.Get("allowed_operations").([]interface{}),
+		MaxAgeSeconds:     d.Get("max_age_seconds").(int),
+		AllowedHeaders:    d.Get("allowed_headers").([]interface{}),
+		ExposeHeaders:     d.Get("expose_headers").([]interface{}),
+	}
+
+	var output BucketCorsRuleOutput
+	err := client.Apply(ctx, OpResourceCreate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", output.BucketId, output.CorsRuleName))
+
+	err = client.Populate(ctx, OpResourceCreate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketCorsRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketCorsRuleInput{
+		BucketId:          d.Get("bucket_id").(string),
+		CorsRuleName:      d.Get("cors_rule_name").(string),
+		AllowedOperations: d.Get("allowed_operations").([]interface{}),
+	}
+
+	var output BucketCorsRuleOutput
+	err := client.Apply(ctx, OpResourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if output.CorsRuleName == "" && !d.IsNewResource() {
+		// deleted CORS rule or bucket
+		tflog.Warn(ctx, "CORS rule not found, possible resource drift", map[string]interface{}{
+			"bucket_id":      input.BucketId,
+			"cors_rule_name": input.CorsRuleName,
+		})
+		d.SetId("")
+		return nil
+	}
+
+	err = client.Populate(ctx, OpResourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketCorsRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketCorsRuleInput{
+		BucketId:          d.Get("bucket_id").(string),
+		CorsRuleName:      d.Get("cors_rule_name").(string),
+		AllowedOrigins:    d.Get("allowed_origins").([]interface{}),
+		AllowedOperations: d.Get("allowed_operations").([]interface{}),
+		MaxAgeSeconds:     d.Get("max_age_seconds").(int),
+		AllowedHeaders:    d.Get("allowed_headers").([]interface{}),
+		ExposeHeaders:     d.Get("expose_headers").([]interface{}),
+	}
+
+	var output BucketCorsRuleOutput
+	err := client.Apply(ctx, OpResourceUpdate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Populate(ctx, OpResourceUpdate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketCorsRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketCorsRuleInput{
+		BucketId:     d.Get("bucket_id").(string),
+		CorsRuleName: d.Get("cors_rule_name").(string),
+	}
+
+	err := client.Apply(ctx, OpResourceDelete, &input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,96 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_cors_rule_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceB2BucketCorsRule_basic(t *testing.T) {
+	parentResourceName := "b2_bucket.test"
+	resourceName := "b2_bucket_cors_rule.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketCorsRuleConfig_basic(bucketName, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket_id", parentResourceName, "bucket_id"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule_name", "downloadFromAnyOrigin"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.0", "https"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_operations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_operations.0", "b2_download_file_by_id"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_operations.1", "b2_download_file_by_name"),
+					resource.TestCheckResourceAttr(resourceName, "max_age_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_headers.0", "range"),
+					resource.TestCheckResourceAttr(resourceName, "expose_headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "expose_headers.0", "x-bz-content-sha1"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision"),
+					resource.TestCheckResourceAttr(parentResourceName, "cors_rules.#", "1"),
+					resource.TestCheckResourceAttr(parentResourceName, "cors_rules.0.cors_rule_name", "managedByBucket"),
+					testAccCheckBucketRuleKeys(parentResourceName, "corsRules",
+						"corsRuleName", "allowedOrigins", "allowedOperations", "allowedHeaders", "exposeHeaders", "maxAgeSeconds"),
+				),
+			},
+			{
+				Config: testAccResourceB2BucketCorsRuleConfig_basic(bucketName, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_age_seconds", "7200"),
+					resource.TestCheckResourceAttr(parentResourceName, "cors_rules.#", "1"),
+					testAccCheckBucketRuleKeys(parentResourceName, "corsRules",
+						"corsRuleName", "allowedOrigins", "allowedOperations", "allowedHeaders", "exposeHeaders", "maxAgeSeconds"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceB2BucketCorsRuleConfig_basic(bucketName string, maxAgeSeconds int) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name           = "%s"
+  bucket_type           = "allPublic"
+  ignore_external_rules = true
+
+  cors_rules {
+    cors_rule_name     = "managedByBucket"
+    allowed_origins    = ["https"]
+    allowed_operations = ["b2_download_file_by_name"]
+    max_age_seconds    = 60
+  }
+}
+
+resource "b2_bucket_cors_rule" "test" {
+  bucket_id          = b2_bucket.test.bucket_id
+  cors_rule_name     = "downloadFromAnyOrigin"
+  allowed_origins    = ["https"]
+  allowed_operations = ["b2_download_file_by_id", "b2_download_file_by_name"]
+  max_age_seconds    = %d
+  allowed_headers    = ["range"]
+  expose_headers     = ["x-bz-content-sha1"]
+}
+`, bucketName, maxAgeSeconds)
+}
@@ -0,0 +1,137 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_file_hide.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceB2BucketFileHide() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 bucket file hide marker resource. Destroying the resource deletes the hide marker, which unhides the file." +
+			" It can be imported by the file ID of the hide marker.",
+
+		CreateContext: resourceB2BucketFileHideCreate,
+		ReadContext:   resourceB2BucketFileHideRead,
+		DeleteContext: resourceB2BucketFileHideDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Description:  "The ID of the bucket.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"file_name": {
+				Description:  "The name of the B2 file to hide.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"action": {
+				Description: "One of 'start', 'upload', 'hide', 'folder', or other values added in the future.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"file_id": {
+				Description: "The unique identifier of the hide marker.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"upload_timestamp": {
+				Description: "This is a UTC time when the hide marker was created.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceB2BucketFileHideCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileHideInput{
+		BucketId: d.Get("bucket_id").(string),
+		FileName: d.Get("file_name").(string),
+	}
+
+	var output BucketFileHideOutput
+	err := client.Apply(ctx, OpResourceCreate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(output.FileId)
+
+	err = client.Populate(ctx, OpResourceCreate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketFileHideRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileHideInput{
+		FileId: d.Id(),
+	}
+
+	var output BucketFileHideOutput
+	err := client.Apply(ctx, OpResourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if output.FileId == "" && !d.IsNewResource() {
+		// deleted hide marker
+		tflog.Warn(ctx, "Hide marker not found, possible resource drift", map[string]interface{}{
+			"file_id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	}
+
+	err = client.Populate(ctx, OpResourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketFileHideDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileHideInput{
+		FileId:   d.Id(),
+		FileName: d.Get("file_name").(string),
+	}
+
+	err := client.Apply(ctx, OpResourceDelete, &input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
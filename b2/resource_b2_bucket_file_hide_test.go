@@ -0,0 +1,81 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_file_hide_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceB2BucketFileHide_basic(t *testing.T) {
+	parentResourceName := "b2_bucket.test"
+	resourceName := "b2_bucket_file_hide.test"
+	dataSourceName := "data.b2_bucket_file.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketFileHideConfig_basic(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "hide"),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket_id", parentResourceName, "bucket_id"),
+					resource.TestCheckResourceAttr(resourceName, "file_name", "temp.txt"),
+					resource.TestMatchResourceAttr(resourceName, "file_id", regexp.MustCompile("^4_z.+$")),
+					resource.TestMatchResourceAttr(resourceName, "upload_timestamp", regexp.MustCompile("^[0-9]{13}$")),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.0.action", "hide"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_versions.0.file_id", resourceName, "file_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceB2BucketFileHideConfig_basic(bucketName string, tempFile string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+}
+
+resource "b2_bucket_file_hide" "test" {
+  bucket_id = b2_bucket_file_version.test.bucket_id
+  file_name = b2_bucket_file_version.test.file_name
+}
+
+data "b2_bucket_file" "test" {
+  bucket_id = b2_bucket_file_hide.test.bucket_id
+  file_name = b2_bucket_file_hide.test.file_name
+}
+`, bucketName, tempFile)
+}
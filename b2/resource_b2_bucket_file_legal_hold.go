@@ -0,0 +1,148 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_file_legal_hold.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceB2BucketFileLegalHold() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 bucket file legal hold resource. Destroying the resource turns the legal hold off.",
+
+		CreateContext: resourceB2BucketFileLegalHoldCreate,
+		ReadContext:   resourceB2BucketFileLegalHoldRead,
+		UpdateContext: resourceB2BucketFileLegalHoldUpdate,
+		DeleteContext: resourceB2BucketFileLegalHoldDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"file_id": {
+				Description:  "The ID of the file version.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"legal_hold": {
+				Description:  "Legal hold status (on|off).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "on",
+				ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+			},
+			"file_name": {
+				Description: "The name of the B2 file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceB2BucketFileLegalHoldCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileLegalHoldInput{
+		FileId:    d.Get("file_id").(string),
+		LegalHold: d.Get("legal_hold").(string),
+	}
+
+	var output BucketFileLegalHoldOutput
+	err := client.Apply(ctx, OpResourceCreate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(output.FileId)
+
+	err = client.Populate(ctx, OpResourceCreate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketFileLegalHoldRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileLegalHoldInput{
+		FileId: d.Id(),
+	}
+
+	var output BucketFileLegalHoldOutput
+	err := client.Apply(ctx, OpResourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if output.FileId == "" && !d.IsNewResource() {
+		// deleted file version
+		tflog.Warn(ctx, "File version not found, possible resource drift", map[string]interface{}{
+			"file_id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	}
+
+	err = client.Populate(ctx, OpResourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketFileLegalHoldUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileLegalHoldInput{
+		FileId:    d.Id(),
+		LegalHold: d.Get("legal_hold").(string),
+	}
+
+	var output BucketFileLegalHoldOutput
+	err := client.Apply(ctx, OpResourceUpdate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Populate(ctx, OpResourceUpdate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketFileLegalHoldDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileLegalHoldInput{
+		FileId: d.Id(),
+	}
+
+	err := client.Apply(ctx, OpResourceDelete, &input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,78 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_file_legal_hold_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceB2BucketFileLegalHold_basic(t *testing.T) {
+	parentResourceName := "b2_bucket_file_version.test"
+	resourceName := "b2_bucket_file_legal_hold.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketFileLegalHoldConfig_basic(bucketName, tempFile, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "file_id", parentResourceName, "file_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "file_name", parentResourceName, "file_name"),
+					resource.TestCheckResourceAttr(resourceName, "legal_hold", "on"),
+				),
+			},
+			{
+				Config: testAccResourceB2BucketFileLegalHoldConfig_basic(bucketName, tempFile, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "legal_hold", "off"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceB2BucketFileLegalHoldConfig_basic(bucketName string, tempFile string, legalHold string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+  file_lock_configuration {
+    is_file_lock_enabled = true
+  }
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+}
+
+resource "b2_bucket_file_legal_hold" "test" {
+  file_id = b2_bucket_file_version.test.file_id
+  legal_hold = "%s"
+}
+`, bucketName, tempFile, legalHold)
+}
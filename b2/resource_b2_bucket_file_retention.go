@@ -0,0 +1,186 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_file_retention.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceB2BucketFileRetention() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 bucket file retention resource.",
+
+		CreateContext: resourceB2BucketFileRetentionCreate,
+		ReadContext:   resourceB2BucketFileRetentionRead,
+		UpdateContext: resourceB2BucketFileRetentionUpdate,
+		DeleteContext: resourceB2BucketFileRetentionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"file_id": {
+				Description:  "The ID of the file version.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"mode": {
+				Description:  "File retention mode (compliance|governance).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"compliance", "governance"}, false),
+			},
+			"retain_until_timestamp": {
+				Description:  "Until when the file is immutable, in milliseconds since 1970.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"bypass_governance": {
+				Description: "Allow shortening or removing governance mode retention. Required to remove the retention on destroy.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"file_name": {
+				Description: "The name of the B2 file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceB2BucketFileRetentionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileRetentionInput{
+		FileId:               d.Get("file_id").(string),
+		Mode:                 d.Get("mode").(string),
+		RetainUntilTimestamp: d.Get("retain_until_timestamp").(int),
+		BypassGovernance:     d.Get("bypass_governance").(bool),
+	}
+
+	var output BucketFileRetentionOutput
+	err := client.Apply(ctx, OpResourceCreate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(output.FileId)
+
+	// This field is not returned by the API but is needed for the resource
+	output.BypassGovernance = input.BypassGovernance
+
+	err = client.Populate(ctx, OpResourceCreate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketFileRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileRetentionInput{
+		FileId: d.Id(),
+	}
+
+	var output BucketFileRetentionOutput
+	err := client.Apply(ctx, OpResourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if output.FileId == "" && !d.IsNewResource() {
+		// deleted file version
+		tflog.Warn(ctx, "File version not found, possible resource drift", map[string]interface{}{
+			"file_id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	}
+
+	// This field is not returned by the API but is needed for the resource
+	output.BypassGovernance = d.Get("bypass_governance").(bool)
+
+	err = client.Populate(ctx, OpResourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketFileRetentionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketFileRetentionInput{
+		FileId:               d.Id(),
+		Mode:                 d.Get("mode").(string),
+		RetainUntilTimestamp: d.Get("retain_until_timestamp").(int),
+		BypassGovernance:     d.Get("bypass_governance").(bool),
+	}
+
+	var output BucketFileRetentionOutput
+	err := client.Apply(ctx, OpResourceUpdate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// This field is not returned by the API but is needed for the resource
+	output.BypassGovernance = input.BypassGovernance
+
+	err = client.Populate(ctx, OpResourceUpdate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketFileRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	if d.Get("mode").(string) == "compliance" {
+		// B2 does not allow removing compliance mode retention before it expires
+		d.SetId("")
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "File retention not removed",
+				Detail: fmt.Sprintf("File %q is in compliance mode and stays immutable until its retention expires; it was only removed from the Terraform state.",
+					d.Get("file_name").(string)),
+			},
+		}
+	}
+
+	input := BucketFileRetentionInput{
+		FileId:           d.Id(),
+		BypassGovernance: d.Get("bypass_governance").(bool),
+	}
+
+	err := client.Apply(ctx, OpResourceDelete, &input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
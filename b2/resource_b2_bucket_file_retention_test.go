@@ -0,0 +1,89 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_file_retention_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceB2BucketFileRetention_basic(t *testing.T) {
+	parentResourceName := "b2_bucket_file_version.test"
+	resourceName := "b2_bucket_file_retention.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+	retainUntil := time.Now().Add(24 * time.Hour).UnixMilli()
+	retainUntilShorter := time.Now().Add(12 * time.Hour).UnixMilli()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketFileRetentionConfig_basic(bucketName, tempFile, retainUntil),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "file_id", parentResourceName, "file_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "file_name", parentResourceName, "file_name"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "governance"),
+					resource.TestCheckResourceAttr(resourceName, "retain_until_timestamp", strconv.FormatInt(retainUntil, 10)),
+					resource.TestCheckResourceAttr(resourceName, "bypass_governance", "true"),
+				),
+			},
+			{
+				Config: testAccResourceB2BucketFileRetentionConfig_basic(bucketName, tempFile, retainUntilShorter),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mode", "governance"),
+					resource.TestCheckResourceAttr(resourceName, "retain_until_timestamp", strconv.FormatInt(retainUntilShorter, 10)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bypass_governance"},
+			},
+		},
+	})
+}
+
+func testAccResourceB2BucketFileRetentionConfig_basic(bucketName string, tempFile string, retainUntil int64) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+  file_lock_configuration {
+    is_file_lock_enabled = true
+  }
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+  bypass_governance = true
+}
+
+resource "b2_bucket_file_retention" "test" {
+  file_id = b2_bucket_file_version.test.file_id
+  mode = "governance"
+  retain_until_timestamp = %d
+  bypass_governance = true
+}
+`, bucketName, tempFile, retainUntil)
+}
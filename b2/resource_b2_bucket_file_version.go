@@ -24,6 +24,7 @@ func resourceB2BucketFileVersion() *schema.Resource {
 
 		CreateContext: resourceB2BucketFileVersionCreate,
 		ReadContext:   resourceB2BucketFileVersionRead,
+		UpdateContext: resourceB2BucketFileVersionUpdate,
 		DeleteContext: resourceB2BucketFileVersionDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -85,6 +86,43 @@ func resourceB2BucketFileVersion() *schema.Resource {
 					return old == "none" && new == ""
 				},
 			},
+			"file_retention": {
+				Description: "File retention settings. When not set, the default retention of the bucket applies. When the application key is not allowed to read it, the value of the state is kept, see `file_retention_readable`.",
+				Type:        schema.TypeList,
+				Elem:        getFileRetentionElem(false),
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+			},
+			"legal_hold": {
+				Description:  "Legal hold status (on|off). When the application key is not allowed to read it, the value of the state is kept, see `legal_hold_readable`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+			},
+			"file_retention_readable": {
+				Description: "Whether the application key is allowed to read the file retention settings.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"legal_hold_readable": {
+				Description: "Whether the application key is allowed to read the legal hold status.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"bypass_governance": {
+				Description: "Allow shortening or removing governance mode retention when updating `file_retention` or deleting the file version.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"destroy_mode": {
+				Description:  "What to do with the file when the resource is destroyed: 'delete' removes this file version, 'hide' creates a hide marker for the file name instead, which can be reverted by deleting the marker.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "delete",
+				ValidateFunc: validation.StringInSlice([]string{"delete", "hide"}, false),
+			},
 			"action": {
 				Description: "One of 'start', 'upload', 'hide', 'folder', or other values added in the future.",
 				Type:        schema.TypeString,
@@ -115,6 +153,26 @@ func resourceB2BucketFileVersion() *schema.Resource {
 				Type:        schema.TypeInt,
 				Computed:    true,
 			},
+			"replication_status": {
+				Description: "Replication status of the file (pending|completed|failed|replica). Empty when the file is not replicated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"sse_c_key_id": {
+				Description: "Identifier of the key used in SSE-C mode, from the file info.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"src_last_modified_millis": {
+				Description: "Last modification time of the source file, in milliseconds since 1970, from the file info. 0 when the file info does not have it.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"large_file_sha1": {
+				Description: "SHA1 hash of the content of a large file, from the file info.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -129,6 +187,8 @@ func resourceB2BucketFileVersionCreate(ctx context.Context, d *schema.ResourceDa
 		ContentType:          d.Get("content_type").(string),
 		FileInfo:             d.Get("file_info").(map[string]interface{}),
 		ServerSideEncryption: d.Get("server_side_encryption").([]interface{}),
+		FileRetention:        d.Get("file_retention").([]interface{}),
+		LegalHold:            d.Get("legal_hold").(string),
 	}
 
 	var output BucketFileVersionOutput
@@ -139,7 +199,11 @@ func resourceB2BucketFileVersionCreate(ctx context.Context, d *schema.ResourceDa
 
 	d.SetId(output.FileId)
 
-	err = client.Populate(ctx, OpResourceCreate, &output, d)
+	// These fields are not returned by the API but are needed for the resource
+	output.DestroyMode = d.Get("destroy_mode").(string)
+	output.BypassGovernance = d.Get("bypass_governance").(bool)
+
+	err = populateBucketFileVersion(ctx, client, OpResourceCreate, &output, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -163,8 +227,51 @@ func resourceB2BucketFileVersionRead(ctx context.Context, d *schema.ResourceData
 	// These fields are not returned by the API but are needed for the resource
 	output.BucketId = d.Get("bucket_id").(string)
 	output.Source = d.Get("source").(string)
+	output.DestroyMode = d.Get("destroy_mode").(string)
+	output.BypassGovernance = d.Get("bypass_governance").(bool)
+
+	err = populateBucketFileVersion(ctx, client, OpResourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketFileVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	// destroy_mode and bypass_governance are used by other operations only,
+	// so there is nothing to change in B2 unless the file lock settings changed
+	if !d.HasChanges("file_retention", "legal_hold") {
+		return resourceB2BucketFileVersionRead(ctx, d, meta)
+	}
+
+	input := BucketFileVersionInput{
+		FileId:           d.Id(),
+		FileName:         d.Get("file_name").(string),
+		BypassGovernance: d.Get("bypass_governance").(bool),
+	}
+	if d.HasChange("file_retention") {
+		input.FileRetention = d.Get("file_retention").([]interface{})
+	}
+	if d.HasChange("legal_hold") {
+		input.LegalHold = d.Get("legal_hold").(string)
+	}
+
+	var output BucketFileVersionOutput
+	err := client.Apply(ctx, OpResourceUpdate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// These fields are not returned by the API but are needed for the resource
+	output.BucketId = d.Get("bucket_id").(string)
+	output.Source = d.Get("source").(string)
+	output.DestroyMode = d.Get("destroy_mode").(string)
+	output.BypassGovernance = d.Get("bypass_governance").(bool)
 
-	err = client.Populate(ctx, OpResourceRead, &output, d)
+	err = populateBucketFileVersion(ctx, client, OpResourceUpdate, &output, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -176,8 +283,11 @@ func resourceB2BucketFileVersionDelete(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*Client)
 
 	input := BucketFileVersionInput{
-		FileId:   d.Id(),
-		FileName: d.Get("file_name").(string),
+		FileId:           d.Id(),
+		BucketId:         d.Get("bucket_id").(string),
+		FileName:         d.Get("file_name").(string),
+		DestroyMode:      d.Get("destroy_mode").(string),
+		BypassGovernance: d.Get("bypass_governance").(bool),
 	}
 
 	err := client.Apply(ctx, OpResourceDelete, &input, nil)
@@ -189,3 +299,27 @@ func resourceB2BucketFileVersionDelete(ctx context.Context, d *schema.ResourceDa
 
 	return nil
 }
+
+// populateBucketFileVersion populates the file version, and keeps the file retention and
+// legal hold of the state, or of the configuration on create, that the application key
+// is not allowed to read, so that they do not show up as changed.
+func populateBucketFileVersion(ctx context.Context, client *Client, op Operation, output *BucketFileVersionOutput, d *schema.ResourceData) error {
+	fileRetention := d.Get("file_retention")
+	legalHold := d.Get("legal_hold")
+
+	if err := client.Populate(ctx, op, output, d); err != nil {
+		return err
+	}
+
+	if !output.FileRetentionReadable {
+		if err := d.Set("file_retention", fileRetention); err != nil {
+			return err
+		}
+	}
+	if !output.LegalHoldReadable {
+		if err := d.Set("legal_hold", legalHold); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"regexp"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -42,6 +43,10 @@ func TestAccResourceB2BucketFileVersion_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "content_sha1", "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
 					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
 					resource.TestCheckResourceAttr(resourceName, "file_info.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "replication_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "sse_c_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "src_last_modified_millis", "0"),
+					resource.TestCheckResourceAttr(resourceName, "large_file_sha1", ""),
 					resource.TestCheckResourceAttr(resourceName, "file_name", "temp.txt"),
 					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.0.mode", "none"),
@@ -151,6 +156,7 @@ func TestAccResourceB2BucketFileVersion_largeFile(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
 					resource.TestCheckResourceAttr(resourceName, "file_info.%", "1"),
 					resource.TestMatchResourceAttr(resourceName, "file_info.large_file_sha1", regexp.MustCompile("^[a-z0-9]{40}$")),
+					resource.TestCheckResourceAttrPair(resourceName, "large_file_sha1", resourceName, "file_info.large_file_sha1"),
 					resource.TestCheckResourceAttr(resourceName, "file_name", "temp.txt"),
 					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.0.mode", "none"),
@@ -187,6 +193,7 @@ func TestAccResourceB2BucketFileVersion_sse_c(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "file_info.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "file_info.description", "the file"),
 					resource.TestCheckResourceAttr(resourceName, "file_info.sse_c_key_id", "test_id"),
+					resource.TestCheckResourceAttr(resourceName, "sse_c_key_id", "test_id"),
 					resource.TestCheckResourceAttr(resourceName, "file_name", "temp.bin"),
 					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.0.mode", "SSE-C"),
@@ -200,6 +207,93 @@ func TestAccResourceB2BucketFileVersion_sse_c(t *testing.T) {
 	})
 }
 
+func TestAccResourceB2BucketFileVersion_destroyMode(t *testing.T) {
+	resourceName := "b2_bucket_file_version.test"
+	dataSourceName := "data.b2_bucket_files.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketFileVersionConfig_basic(bucketName, tempFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "destroy_mode", "delete"),
+				),
+			},
+			{
+				Config: testAccResourceB2BucketFileVersionConfig_destroyMode(bucketName, tempFile, "hide"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "destroy_mode", "hide"),
+					resource.TestCheckResourceAttr(resourceName, "action", "upload"),
+				),
+			},
+			{
+				// the file version is destroyed in the "hide" mode
+				Config: testAccResourceB2BucketFileVersionConfig_hidden(bucketName),
+			},
+			{
+				Config: testAccResourceB2BucketFileVersionConfig_hiddenVersions(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.0.file_name", "temp.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.0.action", "hide"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.1.file_name", "temp.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.1.action", "upload"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_versions.1.size", "5"),
+				),
+			},
+			{
+				// the bucket cannot be deleted with a hidden file in it
+				PreConfig: func() { testAccDeleteFileVersions(t, bucketName) },
+				Config:    testAccResourceB2BucketFileVersionConfig_hidden(bucketName),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2BucketFileVersion_fileLock(t *testing.T) {
+	resourceName := "b2_bucket_file_version.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	tempFile := createTempFileString(t, "hello")
+	defer func() { _ = os.Remove(tempFile) }()
+	retainUntil := time.Now().Add(24 * time.Hour).UnixMilli()
+	retainUntilLonger := time.Now().Add(48 * time.Hour).UnixMilli()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketFileVersionConfig_fileLock(bucketName, tempFile, retainUntil, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_retention.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_retention.0.mode", "governance"),
+					resource.TestCheckResourceAttr(resourceName, "file_retention.0.retain_until_timestamp", strconv.FormatInt(retainUntil, 10)),
+					resource.TestCheckResourceAttr(resourceName, "legal_hold", "on"),
+					resource.TestCheckResourceAttr(resourceName, "file_retention_readable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "legal_hold_readable", "true"),
+				),
+			},
+			{
+				// updated in place
+				Config: testAccResourceB2BucketFileVersionConfig_fileLock(bucketName, tempFile, retainUntilLonger, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_retention.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_retention.0.mode", "governance"),
+					resource.TestCheckResourceAttr(resourceName, "file_retention.0.retain_until_timestamp", strconv.FormatInt(retainUntilLonger, 10)),
+					resource.TestCheckResourceAttr(resourceName, "legal_hold", "off"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceB2BucketFileVersionConfig_basic(bucketName string, tempFile string) string {
 	return fmt.Sprintf(`
 resource "b2_bucket" "test" {
@@ -264,3 +358,67 @@ resource "b2_bucket_file_version" "test" {
 }
 `, bucketName, tempFile)
 }
+
+func testAccResourceB2BucketFileVersionConfig_destroyMode(bucketName string, tempFile string, destroyMode string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+  destroy_mode = "%s"
+}
+`, bucketName, tempFile, destroyMode)
+}
+
+func testAccResourceB2BucketFileVersionConfig_hidden(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+`, bucketName)
+}
+
+func testAccResourceB2BucketFileVersionConfig_hiddenVersions(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+data "b2_bucket_files" "test" {
+  bucket_id = b2_bucket.test.id
+  show_versions = true
+  recursive = true
+}
+`, bucketName)
+}
+
+func testAccResourceB2BucketFileVersionConfig_fileLock(bucketName string, tempFile string, retainUntil int64, legalHold string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+  file_lock_configuration {
+    is_file_lock_enabled = true
+  }
+}
+
+resource "b2_bucket_file_version" "test" {
+  bucket_id = b2_bucket.test.id
+  file_name = "temp.txt"
+  source = "%s"
+  file_retention {
+    mode = "governance"
+    retain_until_timestamp = %d
+  }
+  legal_hold = "%s"
+  bypass_governance = true
+}
+`, bucketName, tempFile, retainUntil, legalHold)
+}
@@ -0,0 +1,177 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_lifecycle_rule.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceB2BucketLifecycleRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 bucket lifecycle rule resource. It manages a single lifecycle rule of a bucket, leaving the other rules untouched." +
+			" Set `ignore_external_rules` of the `b2_bucket` resource managing the same bucket.",
+
+		CreateContext: resourceB2BucketLifecycleRuleCreate,
+		ReadContext:   resourceB2BucketLifecycleRuleRead,
+		UpdateContext: resourceB2BucketLifecycleRuleUpdate,
+		DeleteContext: resourceB2BucketLifecycleRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importBucketRuleState("file_name_prefix"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Description:  "The ID of the bucket.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"file_name_prefix": {
+				Description: "It specifies which files in the bucket it applies to. It identifies the rule within the bucket.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"days_from_hiding_to_deleting": {
+				Description:  "It says how long to keep file versions that are not the current version.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"days_from_uploading_to_hiding": {
+				Description:  "It causes files to be hidden automatically after the given number of days.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"days_from_starting_to_canceling_unfinished_large_files": {
+				Description:  "It cancels any unfinished large file versions after a given number of days.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"revision": {
+				Description: "Bucket revision after the last change of the rule.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceB2BucketLifecycleRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketLifecycleRuleInput{
+		BucketId:                  d.Get("bucket_id").(string),
+		FileNamePrefix:            d.Get("file_name_prefix").(string),
+		DaysFromHidingToDeleting:  d.Get("days_from_hiding_to_deleting").(int),
+		DaysFromUploadingToHiding: d.Get("days_from_uploading_to_hiding").(int),
+		DaysFromStartingToCancelingUnfinishedLargeFiles: d.Get("days_from_starting_to_canceling_unfinished_large_files").(int),
+	}
+
+	var output BucketLifecycleRuleOutput
+	err := client.Apply(ctx, OpResourceCreate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", output.BucketId, output.FileNamePrefix))
+
+	err = client.Populate(ctx, OpResourceCreate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketLifecycleRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketLifecycleRuleInput{
+		BucketId:       d.Get("bucket_id").(string),
+		FileNamePrefix: d.Get("file_name_prefix").(string),
+	}
+
+	var output BucketLifecycleRuleOutput
+	err := client.Apply(ctx, OpResourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// file_name_prefix may be empty, so the bucket ID tells whether the rule was found
+	if output.BucketId == "" && !d.IsNewResource() {
+		// deleted lifecycle rule or bucket
+		tflog.Warn(ctx, "Lifecycle rule not found, possible resource drift", map[string]interface{}{
+			"bucket_id":        input.BucketId,
+			"file_name_prefix": input.FileNamePrefix,
+		})
+		d.SetId("")
+		return nil
+	}
+
+	err = client.Populate(ctx, OpResourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketLifecycleRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketLifecycleRuleInput{
+		BucketId:                  d.Get("bucket_id").(string),
+		FileNamePrefix:            d.Get("file_name_prefix").(string),
+		DaysFromHidingToDeleting:  d.Get("days_from_hiding_to_deleting").(int),
+		DaysFromUploadingToHiding: d.Get("days_from_uploading_to_hiding").(int),
+		DaysFromStartingToCancelingUnfinishedLargeFiles: d.Get("days_from_starting_to_canceling_unfinished_large_files").(int),
+	}
+
+	var output BucketLifecycleRuleOutput
+	err := client.Apply(ctx, OpResourceUpdate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Populate(ctx, OpResourceUpdate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketLifecycleRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketLifecycleRuleInput{
+		BucketId:       d.Get("bucket_id").(string),
+		FileNamePrefix: d.Get("file_name_prefix").(string),
+	}
+
+	err := client.Apply(ctx, OpResourceDelete, &input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
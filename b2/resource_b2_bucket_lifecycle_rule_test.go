@@ -0,0 +1,84 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_lifecycle_rule_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceB2BucketLifecycleRule_basic(t *testing.T) {
+	parentResourceName := "b2_bucket.test"
+	resourceName := "b2_bucket_lifecycle_rule.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketLifecycleRuleConfig_basic(bucketName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket_id", parentResourceName, "bucket_id"),
+					resource.TestCheckResourceAttr(resourceName, "file_name_prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "days_from_hiding_to_deleting", "7"),
+					resource.TestCheckResourceAttr(resourceName, "days_from_uploading_to_hiding", "0"),
+					resource.TestCheckResourceAttr(resourceName, "days_from_starting_to_canceling_unfinished_large_files", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision"),
+					resource.TestCheckResourceAttr(parentResourceName, "lifecycle_rules.#", "1"),
+					resource.TestCheckResourceAttr(parentResourceName, "lifecycle_rules.0.file_name_prefix", "tmp/"),
+					testAccCheckBucketRuleKeys(parentResourceName, "lifecycleRules", "fileNamePrefix",
+						"daysFromHidingToDeleting", "daysFromUploadingToHiding", "daysFromStartingToCancelingUnfinishedLargeFiles"),
+				),
+			},
+			{
+				Config: testAccResourceB2BucketLifecycleRuleConfig_basic(bucketName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "days_from_hiding_to_deleting", "30"),
+					resource.TestCheckResourceAttr(parentResourceName, "lifecycle_rules.#", "1"),
+					testAccCheckBucketRuleKeys(parentResourceName, "lifecycleRules", "fileNamePrefix",
+						"daysFromHidingToDeleting", "daysFromUploadingToHiding", "daysFromStartingToCancelingUnfinishedLargeFiles"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceB2BucketLifecycleRuleConfig_basic(bucketName string, daysFromHidingToDeleting int) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name           = "%s"
+  bucket_type           = "allPrivate"
+  ignore_external_rules = true
+
+  lifecycle_rules {
+    file_name_prefix             = "tmp/"
+    days_from_hiding_to_deleting = 1
+  }
+}
+
+resource "b2_bucket_lifecycle_rule" "test" {
+  bucket_id                                              = b2_bucket.test.bucket_id
+  file_name_prefix                                       = "logs/"
+  days_from_hiding_to_deleting                           = %d
+  days_from_starting_to_canceling_unfinished_large_files = 1
+}
+`, bucketName, daysFromHidingToDeleting)
+}
@@ -12,6 +12,11 @@ package b2
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,6 +33,8 @@ func resourceB2BucketNotificationRules() *schema.Resource {
 		UpdateContext: resourceB2BucketNotificationRulesUpdate,
 		DeleteContext: resourceB2BucketNotificationRulesDelete,
 
+		CustomizeDiff: resourceB2BucketNotificationRulesCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket_id": {
 				Description:  "The ID of the bucket.",
@@ -43,6 +50,30 @@ func resourceB2BucketNotificationRules() *schema.Resource {
 				Required:    true,
 				MinItems:    1,
 			},
+			"exclusive": {
+				Description: "Whether the resource manages all the notification rules of the bucket." +
+					" When false, only the rules listed in `notification_rules` are added, updated and removed, and other rules of the bucket are left untouched." +
+					" Several non-exclusive resources of a bucket can be applied in parallel, as the rules are read again after a short while and written again when a concurrent update dropped them;" +
+					" rules written at the same time by other tools may still be dropped.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"resume_suspended": {
+				Description: "Whether to re-enable the notification rules suspended by B2, e.g. after repeated webhook delivery failures." +
+					" A suspended rule is then reported as disabled, so that the next apply resumes it.",
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"generated_signing_secrets": {
+				Description: "The signing secrets generated for the rules with `generate_signing_secret`, by rule name.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -50,24 +81,40 @@ func resourceB2BucketNotificationRules() *schema.Resource {
 func resourceB2BucketNotificationRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client)
 
+	notificationRules, err := applySigningSecrets(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	input := BucketNotificationRulesInput{
 		BucketId:          d.Get("bucket_id").(string),
-		NotificationRules: d.Get("notification_rules").([]interface{}),
+		NotificationRules: notificationRules,
+		Exclusive:         d.Get("exclusive").(bool),
 	}
 
 	var output BucketNotificationRulesOutput
-	err := client.Apply(ctx, OpResourceCreate, &input, &output)
+	err = client.Apply(ctx, OpResourceCreate, &input, &output)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(output.BucketId)
 
+	// These fields are not returned by the API but are needed for the resource
+	output.Exclusive = input.Exclusive
+	output.ResumeSuspended = d.Get("resume_suspended").(bool)
+	separateGeneratedSigningSecrets(&output, notificationRules)
+
 	err = client.Populate(ctx, OpResourceCreate, &output, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	err = restoreSigningSecretSettings(d, notificationRules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -75,7 +122,9 @@ func resourceB2BucketNotificationRulesRead(ctx context.Context, d *schema.Resour
 	client := meta.(*Client)
 
 	input := BucketNotificationRulesInput{
-		BucketId: d.Id(),
+		BucketId:          d.Id(),
+		NotificationRules: d.Get("notification_rules").([]interface{}),
+		Exclusive:         d.Get("exclusive").(bool),
 	}
 
 	var output BucketNotificationRulesOutput
@@ -92,33 +141,85 @@ func resourceB2BucketNotificationRulesRead(ctx context.Context, d *schema.Resour
 		return nil
 	}
 
+	// These fields are not returned by the API but are needed for the resource
+	output.Exclusive = input.Exclusive
+	output.ResumeSuspended = d.Get("resume_suspended").(bool)
+
+	var diags diag.Diagnostics
+	for i, rule := range output.NotificationRules {
+		if !rule.IsSuspended {
+			continue
+		}
+		detail := fmt.Sprintf("Notification rule %q of bucket %s is suspended: %s.", rule.Name, output.BucketId, rule.SuspensionReason)
+		if output.ResumeSuspended {
+			// Report the suspended rule as disabled, so that the next apply re-enables it
+			output.NotificationRules[i].IsEnabled = false
+			detail += " It will be resumed on the next apply."
+		} else {
+			detail += " Set `resume_suspended` to resume it on apply."
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Notification rule suspended",
+			Detail:   detail,
+		})
+	}
+
+	separateGeneratedSigningSecrets(&output, input.NotificationRules)
+
 	err = client.Populate(ctx, OpResourceRead, &output, d)
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
 
-	return nil
+	err = restoreSigningSecretSettings(d, input.NotificationRules)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
 }
 
 func resourceB2BucketNotificationRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client)
 
+	notificationRules, err := applySigningSecrets(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	input := BucketNotificationRulesInput{
 		BucketId:          d.Id(),
-		NotificationRules: d.Get("notification_rules").([]interface{}),
+		NotificationRules: notificationRules,
+		Exclusive:         d.Get("exclusive").(bool),
+	}
+	if !input.Exclusive {
+		// Rules removed from the configuration have to be removed from the bucket as well
+		previousNotificationRules, _ := d.GetChange("notification_rules")
+		input.PreviousNotificationRules = previousNotificationRules.([]interface{})
 	}
 
 	var output BucketNotificationRulesOutput
-	err := client.Apply(ctx, OpResourceUpdate, &input, &output)
+	err = client.Apply(ctx, OpResourceUpdate, &input, &output)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// These fields are not returned by the API but are needed for the resource
+	output.Exclusive = input.Exclusive
+	output.ResumeSuspended = d.Get("resume_suspended").(bool)
+	separateGeneratedSigningSecrets(&output, notificationRules)
+
 	err = client.Populate(ctx, OpResourceUpdate, &output, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	err = restoreSigningSecretSettings(d, notificationRules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -126,7 +227,9 @@ func resourceB2BucketNotificationRulesDelete(ctx context.Context, d *schema.Reso
 	client := meta.(*Client)
 
 	input := BucketNotificationRulesInput{
-		BucketId: d.Id(),
+		BucketId:          d.Id(),
+		NotificationRules: d.Get("notification_rules").([]interface{}),
+		Exclusive:         d.Get("exclusive").(bool),
 	}
 
 	err := client.Apply(ctx, OpResourceDelete, &input, nil)
@@ -138,3 +241,318 @@ func resourceB2BucketNotificationRulesDelete(ctx context.Context, d *schema.Reso
 
 	return nil
 }
+
+func resourceB2BucketNotificationRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := checkGeneratedSigningSecrets(d); err != nil {
+		return err
+	}
+	if err := planSigningSecrets(d); err != nil {
+		return err
+	}
+
+	var rules []NotificationRule
+	names := make(map[string]bool)
+	for i, item := range d.Get("notification_rules").([]interface{}) {
+		rule := item.(map[string]interface{})
+		name, _ := rule["name"].(string)
+		if name != "" {
+			if names[name] {
+				return fmt.Errorf("notification rule name %q is used more than once", name)
+			}
+			names[name] = true
+		}
+		if name == "" || !d.NewValueKnown(fmt.Sprintf("notification_rules.%d.object_name_prefix", i)) ||
+			!d.NewValueKnown(fmt.Sprintf("notification_rules.%d.event_types", i)) {
+			// not known yet, so it cannot be checked for overlaps
+			continue
+		}
+		var eventTypes []string
+		for _, eventType := range rule["event_types"].([]interface{}) {
+			eventType, _ := eventType.(string)
+			eventTypes = append(eventTypes, eventType)
+		}
+		rules = append(rules, NotificationRule{
+			Name:             name,
+			ObjectNamePrefix: rule["object_name_prefix"].(string),
+			EventTypes:       eventTypes,
+			IsEnabled:        rule["is_enabled"].(bool),
+		})
+	}
+
+	if !d.Get("exclusive").(bool) && d.NewValueKnown("bucket_id") && len(names) > 0 {
+		// In non-exclusive mode, the rules must not collide with the rules managed elsewhere
+		owned := make(map[string]bool)
+		if d.Id() != "" && !d.HasChange("bucket_id") {
+			previousNotificationRules, _ := d.GetChange("notification_rules")
+			for _, rule := range previousNotificationRules.([]interface{}) {
+				owned[rule.(map[string]interface{})["name"].(string)] = true
+			}
+		}
+
+		client := meta.(*Client)
+
+		input := BucketNotificationRulesInput{
+			BucketId: d.Get("bucket_id").(string),
+		}
+
+		var output BucketNotificationRulesOutput
+		err := client.Apply(ctx, OpDataSourceRead, &input, &output)
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range output.NotificationRules {
+			if names[rule.Name] && !owned[rule.Name] {
+				return fmt.Errorf("notification rule %q already exists in bucket %s and is not managed by this resource",
+					rule.Name, input.BucketId)
+			}
+			if !names[rule.Name] && !owned[rule.Name] {
+				// the rule stays in the bucket, so it is checked for overlaps too
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	return checkNotificationRulesOverlap(rules)
+}
+
+// checkNotificationRulesOverlap reports every pair of enabled rules which B2 rejects
+// because their object name prefixes overlap and they share an event type.
+func checkNotificationRulesOverlap(rules []NotificationRule) error {
+	var errs []error
+	for i, rule := range rules {
+		if !rule.IsEnabled {
+			continue
+		}
+		for _, other := range rules[i+1:] {
+			if !other.IsEnabled {
+				continue
+			}
+			if !strings.HasPrefix(rule.ObjectNamePrefix, other.ObjectNamePrefix) &&
+				!strings.HasPrefix(other.ObjectNamePrefix, rule.ObjectNamePrefix) {
+				continue
+			}
+			common := intersectEventTypes(expandNotificationEventTypes(rule.EventTypes), expandNotificationEventTypes(other.EventTypes))
+			if len(common) > 0 {
+				errs = append(errs, fmt.Errorf("notification rules %q and %q overlap: object name prefixes %q and %q overlap and both rules match event types %s",
+					rule.Name, other.Name, rule.ObjectNamePrefix, other.ObjectNamePrefix, strings.Join(common, ", ")))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// expandNotificationEventTypes replaces the wildcards with the event types they match.
+func expandNotificationEventTypes(eventTypes []string) map[string]bool {
+	expanded := make(map[string]bool)
+	for _, eventType := range eventTypes {
+		category, isWildcard := strings.CutSuffix(eventType, "*")
+		if !isWildcard {
+			expanded[eventType] = true
+			continue
+		}
+		for _, knownEventType := range notificationEventTypes {
+			if strings.HasPrefix(knownEventType, category) && !strings.HasSuffix(knownEventType, "*") {
+				expanded[knownEventType] = true
+			}
+		}
+	}
+	return expanded
+}
+
+func intersectEventTypes(a, b map[string]bool) []string {
+	var common []string
+	for _, eventType := range notificationEventTypes {
+		if a[eventType] && b[eventType] {
+			common = append(common, eventType)
+		}
+	}
+	return common
+}
+
+// checkGeneratedSigningSecrets rejects rules that both set and generate the signing secret.
+func checkGeneratedSigningSecrets(d *schema.ResourceDiff) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	rawRules := rawConfig.GetAttr("notification_rules")
+	if rawRules.IsNull() || !rawRules.IsKnown() {
+		return nil
+	}
+	for it := rawRules.ElementIterator(); it.Next(); {
+		_, rawRule := it.Element()
+		rawTargetConfigurations := rawRule.GetAttr("target_configuration")
+		if rawTargetConfigurations.IsNull() || !rawTargetConfigurations.IsKnown() {
+			continue
+		}
+		for it := rawTargetConfigurations.ElementIterator(); it.Next(); {
+			_, rawTargetConfiguration := it.Element()
+			generate := rawTargetConfiguration.GetAttr("generate_signing_secret")
+			if generate.IsNull() || !generate.IsKnown() || !generate.True() {
+				continue
+			}
+			if !rawTargetConfiguration.GetAttr("hmac_sha256_signing_secret").IsNull() {
+				return fmt.Errorf("notification rule %q: hmac_sha256_signing_secret cannot be set together with generate_signing_secret",
+					rawRule.GetAttr("name").AsString())
+			}
+		}
+	}
+	return nil
+}
+
+// planSigningSecrets generates the signing secrets during the plan, so that the
+// plan shows the secrets that are applied instead of the previous ones.
+func planSigningSecrets(d *schema.ResourceDiff) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.GetAttr("notification_rules").IsWhollyKnown() {
+		// the rules are not known yet, their secrets are generated during the apply
+		return d.SetNewComputed("generated_signing_secrets")
+	}
+	previousNotificationRules, notificationRules := d.GetChange("notification_rules")
+	previousSecrets, _ := d.GetChange("generated_signing_secrets")
+	secrets, err := fillSigningSecrets(previousNotificationRules.([]interface{}), previousSecrets.(map[string]interface{}),
+		notificationRules.([]interface{}), nil)
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(secrets, previousSecrets) {
+		return nil
+	}
+	return d.SetNew("generated_signing_secrets", secrets)
+}
+
+// applySigningSecrets returns the notification rules to apply, with the signing
+// secrets filled in for the rules that ask for a generated one.
+func applySigningSecrets(d *schema.ResourceData) ([]interface{}, error) {
+	previousNotificationRules, notificationRules := d.GetChange("notification_rules")
+	previousSecrets, plannedSecrets := d.GetChange("generated_signing_secrets")
+	secrets, err := fillSigningSecrets(previousNotificationRules.([]interface{}), previousSecrets.(map[string]interface{}),
+		notificationRules.([]interface{}), plannedSecrets.(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range notificationRules.([]interface{}) {
+		rule := rule.(map[string]interface{})
+		secret, ok := secrets[rule["name"].(string)]
+		if !ok {
+			continue
+		}
+		for _, targetConfiguration := range rule["target_configuration"].([]interface{}) {
+			targetConfiguration.(map[string]interface{})["hmac_sha256_signing_secret"] = secret
+		}
+	}
+	return notificationRules.([]interface{}), nil
+}
+
+// fillSigningSecrets returns the signing secrets of the rules that ask for a
+// generated one, by rule name. A secret planned earlier is used as is, and a
+// previous secret is kept until signing_secret_version changes.
+func fillSigningSecrets(previousNotificationRules []interface{}, previousSecrets map[string]interface{},
+	notificationRules []interface{}, plannedSecrets map[string]interface{}) (map[string]interface{}, error) {
+	previousTargetConfigurations := make(map[string]map[string]interface{})
+	for _, rule := range previousNotificationRules {
+		rule := rule.(map[string]interface{})
+		for _, targetConfiguration := range rule["target_configuration"].([]interface{}) {
+			previousTargetConfigurations[rule["name"].(string)] = targetConfiguration.(map[string]interface{})
+		}
+	}
+
+	secrets := make(map[string]interface{})
+	for _, rule := range notificationRules {
+		rule := rule.(map[string]interface{})
+		name := rule["name"].(string)
+		for _, targetConfiguration := range rule["target_configuration"].([]interface{}) {
+			targetConfiguration := targetConfiguration.(map[string]interface{})
+			if generate, _ := targetConfiguration["generate_signing_secret"].(bool); !generate {
+				continue
+			}
+			if secret, _ := plannedSecrets[name].(string); secret != "" {
+				secrets[name] = secret
+				continue
+			}
+			previous, ok := previousTargetConfigurations[name]
+			if secret, _ := previousSecrets[name].(string); ok && secret != "" && previous["generate_signing_secret"].(bool) &&
+				previous["signing_secret_version"] == targetConfiguration["signing_secret_version"] {
+				secrets[name] = secret
+				continue
+			}
+			secret, err := generateSigningSecret()
+			if err != nil {
+				return nil, err
+			}
+			secrets[name] = secret
+		}
+	}
+	return secrets, nil
+}
+
+func generateSigningSecret() (string, error) {
+	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	// Skip the bytes that would make some characters more likely than others
+	const limit = 256 - 256%len(charset)
+
+	secret := make([]byte, 0, 32)
+	buf := make([]byte, 64)
+	for len(secret) < cap(secret) {
+		if _, err := rand.Read(buf); err != nil {
+			return "", fmt.Errorf("failed to generate signing secret: %w", err)
+		}
+		for _, b := range buf {
+			if int(b) < limit && len(secret) < cap(secret) {
+				secret = append(secret, charset[int(b)%len(charset)])
+			}
+		}
+	}
+	return string(secret), nil
+}
+
+// separateGeneratedSigningSecrets moves the generated signing secrets of the given
+// rules from the rules returned by the API to generated_signing_secrets.
+func separateGeneratedSigningSecrets(output *BucketNotificationRulesOutput, rules []interface{}) {
+	generated := make(map[string]bool)
+	for _, rule := range rules {
+		rule := rule.(map[string]interface{})
+		for _, targetConfiguration := range rule["target_configuration"].([]interface{}) {
+			if generate, _ := targetConfiguration.(map[string]interface{})["generate_signing_secret"].(bool); generate {
+				generated[rule["name"].(string)] = true
+			}
+		}
+	}
+
+	output.GeneratedSigningSecrets = make(map[string]string)
+	for _, rule := range output.NotificationRules {
+		if !generated[rule.Name] || rule.TargetConfiguration == nil {
+			continue
+		}
+		output.GeneratedSigningSecrets[rule.Name] = rule.TargetConfiguration.HmacSha256SigningSecret
+		rule.TargetConfiguration.HmacSha256SigningSecret = ""
+	}
+}
+
+// restoreSigningSecretSettings copies the settings of the generated signing
+// secrets, which are not returned by the API, from the given rules to the state.
+func restoreSigningSecretSettings(d *schema.ResourceData, rules []interface{}) error {
+	settings := make(map[string]map[string]interface{})
+	for _, rule := range rules {
+		rule := rule.(map[string]interface{})
+		for _, targetConfiguration := range rule["target_configuration"].([]interface{}) {
+			settings[rule["name"].(string)] = targetConfiguration.(map[string]interface{})
+		}
+	}
+
+	notificationRules := d.Get("notification_rules").([]interface{})
+	for _, rule := range notificationRules {
+		rule := rule.(map[string]interface{})
+		setting, ok := settings[rule["name"].(string)]
+		if !ok {
+			continue
+		}
+		for _, targetConfiguration := range rule["target_configuration"].([]interface{}) {
+			targetConfiguration := targetConfiguration.(map[string]interface{})
+			targetConfiguration["generate_signing_secret"] = setting["generate_signing_secret"]
+			targetConfiguration["signing_secret_version"] = setting["signing_secret_version"]
+		}
+	}
+	return d.Set("notification_rules", notificationRules)
+}
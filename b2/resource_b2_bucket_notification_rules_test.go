@@ -11,7 +11,10 @@
 package b2
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -133,6 +136,163 @@ func TestAccResourceB2BucketNotificationRules_update(t *testing.T) {
 	})
 }
 
+func TestAccResourceB2BucketNotificationRules_resumeSuspended(t *testing.T) {
+	resourceName := "b2_bucket_notification_rules.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	ruleName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketNotificationRulesConfig_basic(bucketName, ruleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resume_suspended", "false"),
+				),
+			},
+			{
+				Config: testAccResourceB2BucketNotificationRulesConfig_resumeSuspended(bucketName, ruleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resume_suspended", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.0.is_suspended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.0.suspension_reason", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2BucketNotificationRules_nonExclusive(t *testing.T) {
+	firstResourceName := "b2_bucket_notification_rules.first"
+	secondResourceName := "b2_bucket_notification_rules.second"
+	dataSourceName := "data.b2_bucket_notification_rules.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	firstRuleName := acctest.RandomWithPrefix("test-b2-tfp")
+	secondRuleName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketNotificationRulesConfig_nonExclusive(bucketName, firstRuleName, secondRuleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(firstResourceName, "exclusive", "false"),
+					resource.TestCheckResourceAttr(firstResourceName, "notification_rules.#", "1"),
+					resource.TestCheckResourceAttr(firstResourceName, "notification_rules.0.name", firstRuleName),
+					resource.TestCheckResourceAttr(secondResourceName, "exclusive", "false"),
+					resource.TestCheckResourceAttr(secondResourceName, "notification_rules.#", "1"),
+					resource.TestCheckResourceAttr(secondResourceName, "notification_rules.0.name", secondRuleName),
+					resource.TestCheckResourceAttr(dataSourceName, "notification_rules.#", "2"),
+				),
+			},
+			{
+				Config:      testAccResourceB2BucketNotificationRulesConfig_nonExclusive(bucketName, firstRuleName, firstRuleName),
+				ExpectError: regexp.MustCompile("already exists in bucket"),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2BucketNotificationRules_nonExclusiveParallel(t *testing.T) {
+	dataSourceName := "data.b2_bucket_notification_rules.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	firstRuleName := acctest.RandomWithPrefix("test-b2-tfp")
+	secondRuleName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// The resources are created in parallel, a dropped rule would also show up in the plan after the apply
+				Config: testAccResourceB2BucketNotificationRulesConfig_nonExclusiveParallel(bucketName, firstRuleName, secondRuleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "notification_rules.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2BucketNotificationRules_overlap(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceB2BucketNotificationRulesConfig_overlap(bucketName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)notification rules "uploads" and "logs" overlap.*notification rules "logs" and "archive" overlap`),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2BucketNotificationRules_generateSigningSecret(t *testing.T) {
+	resourceName := "b2_bucket_notification_rules.test"
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	ruleName := acctest.RandomWithPrefix("test-b2-tfp")
+	secretAttr := "generated_signing_secrets." + ruleName
+
+	var secret string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketNotificationRulesConfig_generateSigningSecret(bucketName, ruleName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.0.target_configuration.0.generate_signing_secret", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.0.target_configuration.0.signing_secret_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.0.target_configuration.0.hmac_sha256_signing_secret", ""),
+					resource.TestMatchResourceAttr(resourceName, secretAttr, regexp.MustCompile("^[a-zA-Z0-9]{32}$")),
+					resource.TestCheckResourceAttrWith(resourceName, secretAttr, func(value string) error {
+						secret = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("b2_bucket.receiver", "bucket_info.signing_secret_sha1", func(value string) error {
+						return testAccCheckSigningSecretSha1(secret, value)
+					}),
+				),
+			},
+			{
+				Config: testAccResourceB2BucketNotificationRulesConfig_generateSigningSecret(bucketName, ruleName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.0.target_configuration.0.signing_secret_version", "2"),
+					resource.TestMatchResourceAttr(resourceName, secretAttr, regexp.MustCompile("^[a-zA-Z0-9]{32}$")),
+					resource.TestCheckResourceAttrWith(resourceName, secretAttr, func(value string) error {
+						if value == secret {
+							return fmt.Errorf("signing secret was not rotated")
+						}
+						secret = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("b2_bucket.receiver", "bucket_info.signing_secret_sha1", func(value string) error {
+						return testAccCheckSigningSecretSha1(secret, value)
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSigningSecretSha1(secret string, value string) error {
+	sum := sha1.Sum([]byte(secret))
+	if expected := hex.EncodeToString(sum[:]); value != expected {
+		return fmt.Errorf("expected the SHA1 of the signing secret %s, got %s", expected, value)
+	}
+	return nil
+}
+
 func testAccResourceB2BucketNotificationRulesConfig_basic(bucketName string, ruleName string) string {
 	return fmt.Sprintf(`
 resource "b2_bucket" "test" {
@@ -185,3 +345,175 @@ resource "b2_bucket_notification_rules" "test" {
 }
 `, bucketName, ruleName)
 }
+
+func testAccResourceB2BucketNotificationRulesConfig_resumeSuspended(bucketName string, ruleName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+resource "b2_bucket_notification_rules" "test" {
+  bucket_id        = b2_bucket.test.id
+  resume_suspended = true
+  notification_rules {
+    name        = "%s"
+    event_types = ["b2:ObjectCreated:*"]
+    target_configuration {
+      target_type = "webhook"
+      url         = "https://example.com/webhook"
+    }
+  }
+}
+`, bucketName, ruleName)
+}
+
+func testAccResourceB2BucketNotificationRulesConfig_nonExclusive(bucketName string, firstRuleName string, secondRuleName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+resource "b2_bucket_notification_rules" "first" {
+  bucket_id = b2_bucket.test.id
+  exclusive = false
+  notification_rules {
+    name        = "%s"
+    event_types = ["b2:ObjectCreated:*"]
+    target_configuration {
+      target_type = "webhook"
+      url         = "https://example.com/webhook"
+    }
+  }
+}
+
+resource "b2_bucket_notification_rules" "second" {
+  bucket_id = b2_bucket_notification_rules.first.bucket_id
+  exclusive = false
+  notification_rules {
+    name        = "%s"
+    event_types = ["b2:ObjectDeleted:*"]
+    target_configuration {
+      target_type = "webhook"
+      url         = "https://example.com/webhook"
+    }
+  }
+}
+
+data "b2_bucket_notification_rules" "test" {
+  bucket_id = b2_bucket_notification_rules.second.bucket_id
+}
+`, bucketName, firstRuleName, secondRuleName)
+}
+
+func testAccResourceB2BucketNotificationRulesConfig_overlap(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+resource "b2_bucket_notification_rules" "test" {
+  bucket_id = b2_bucket.test.id
+  notification_rules {
+    name        = "uploads"
+    event_types = ["b2:ObjectCreated:*"]
+    target_configuration {
+      target_type = "webhook"
+      url         = "https://example.com/webhook"
+    }
+  }
+  notification_rules {
+    name               = "logs"
+    event_types        = ["b2:ObjectCreated:Upload", "b2:ObjectDeleted:*"]
+    object_name_prefix = "logs/"
+    target_configuration {
+      target_type = "webhook"
+      url         = "https://example.com/webhook"
+    }
+  }
+  notification_rules {
+    name               = "archive"
+    event_types        = ["b2:ObjectDeleted:*"]
+    object_name_prefix = "logs/archive/"
+    target_configuration {
+      target_type = "webhook"
+      url         = "https://example.com/webhook"
+    }
+  }
+}
+`, bucketName)
+}
+
+func testAccResourceB2BucketNotificationRulesConfig_generateSigningSecret(bucketName string, ruleName string, secretVersion string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+resource "b2_bucket_notification_rules" "test" {
+  bucket_id = b2_bucket.test.id
+  notification_rules {
+    name        = "%s"
+    event_types = ["b2:ObjectCreated:*"]
+    target_configuration {
+      target_type             = "webhook"
+      url                     = "https://example.com/webhook"
+      generate_signing_secret = true
+      signing_secret_version  = "%s"
+    }
+  }
+}
+
+# the webhook receiver is configured with the secret known at plan time
+resource "b2_bucket" "receiver" {
+  bucket_name = "%s-receiver"
+  bucket_type = "allPrivate"
+  bucket_info = {
+    signing_secret_sha1 = sha1(b2_bucket_notification_rules.test.generated_signing_secrets["%s"])
+  }
+}
+`, bucketName, ruleName, secretVersion, bucketName, ruleName)
+}
+
+func testAccResourceB2BucketNotificationRulesConfig_nonExclusiveParallel(bucketName string, firstRuleName string, secondRuleName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPublic"
+}
+
+resource "b2_bucket_notification_rules" "first" {
+  bucket_id = b2_bucket.test.id
+  exclusive = false
+  notification_rules {
+    name        = "%s"
+    event_types = ["b2:ObjectCreated:*"]
+    target_configuration {
+      target_type = "webhook"
+      url         = "https://example.com/webhook"
+    }
+  }
+}
+
+resource "b2_bucket_notification_rules" "second" {
+  bucket_id = b2_bucket.test.id
+  exclusive = false
+  notification_rules {
+    name        = "%s"
+    event_types = ["b2:ObjectDeleted:*"]
+    target_configuration {
+      target_type = "webhook"
+      url         = "https://example.com/webhook"
+    }
+  }
+}
+
+data "b2_bucket_notification_rules" "test" {
+  bucket_id  = b2_bucket.test.id
+  depends_on = [b2_bucket_notification_rules.first, b2_bucket_notification_rules.second]
+}
+`, bucketName, firstRuleName, secondRuleName)
+}
@@ -0,0 +1,226 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_replication.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceB2BucketReplication() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 bucket replication rule resource. Both buckets must be accessible with the provider's application key." +
+			" Do not use it together with `replication_configuration` of the `b2_bucket` resource for the same buckets.",
+
+		CreateContext: resourceB2BucketReplicationCreate,
+		ReadContext:   resourceB2BucketReplicationRead,
+		UpdateContext: resourceB2BucketReplicationUpdate,
+		DeleteContext: resourceB2BucketReplicationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"source_bucket_id": {
+				Description:  "The ID of the source bucket.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"destination_bucket_id": {
+				Description:  "The ID of the destination bucket.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"replication_rule_name": {
+				Description:  "A name for the replication rule. The name must be unique among the source bucket's replication rules.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateReplicationRuleName,
+			},
+			"file_name_prefix": {
+				Description: "Only files whose names start with the prefix are replicated.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"priority": {
+				Description:  "The priority of the rule, used when several rules match a file. Higher values take precedence.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      128,
+				ValidateFunc: validation.IntBetween(1, 2147483647),
+			},
+			"include_existing_files": {
+				Description: "Whether files uploaded before the rule was created are replicated too.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"is_enabled": {
+				Description: "Whether the replication rule is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"source_application_key_id": {
+				Description: "The ID of the application key used to read the files of the source bucket." +
+					" When not set, the source key of the bucket's other replication rules is used, or a dedicated key is created." +
+					" All the replication rules of a bucket have to use the same source key.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"destination_application_key_id": {
+				Description: "The ID of the application key used to write the files to the destination bucket." +
+					" When not set, the destination key of the other replication rules with the same source key and destination bucket is used," +
+					" or a dedicated key is created.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"managed_application_key_ids": {
+				Description: "The IDs of the application keys created by the provider and used by this resource." +
+					" They are shared with the other replication rules of the buckets, and deleted with the last replication rule that uses them.",
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceB2BucketReplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketReplicationInput{
+		SourceBucketId:              d.Get("source_bucket_id").(string),
+		DestinationBucketId:         d.Get("destination_bucket_id").(string),
+		ReplicationRuleName:         d.Get("replication_rule_name").(string),
+		FileNamePrefix:              d.Get("file_name_prefix").(string),
+		Priority:                    d.Get("priority").(int),
+		IncludeExistingFiles:        d.Get("include_existing_files").(bool),
+		IsEnabled:                   d.Get("is_enabled").(bool),
+		SourceApplicationKeyId:      d.Get("source_application_key_id").(string),
+		DestinationApplicationKeyId: d.Get("destination_application_key_id").(string),
+	}
+
+	var output BucketReplicationOutput
+	err := client.Apply(ctx, OpResourceCreate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", output.SourceBucketId, output.ReplicationRuleName))
+
+	err = client.Populate(ctx, OpResourceCreate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketReplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketReplicationInput{
+		SourceBucketId:      d.Get("source_bucket_id").(string),
+		ReplicationRuleName: d.Get("replication_rule_name").(string),
+	}
+
+	var output BucketReplicationOutput
+	err := client.Apply(ctx, OpResourceRead, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if output.ReplicationRuleName == "" && !d.IsNewResource() {
+		// deleted replication rule or source bucket
+		tflog.Warn(ctx, "Replication rule not found, possible resource drift", map[string]interface{}{
+			"source_bucket_id":      input.SourceBucketId,
+			"replication_rule_name": input.ReplicationRuleName,
+		})
+		d.SetId("")
+		return nil
+	}
+
+	// This field is not returned by the API but is needed for the resource
+	output.ManagedApplicationKeyIds = d.Get("managed_application_key_ids").(*schema.Set).List()
+
+	err = client.Populate(ctx, OpResourceRead, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketReplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketReplicationInput{
+		SourceBucketId:         d.Get("source_bucket_id").(string),
+		DestinationBucketId:    d.Get("destination_bucket_id").(string),
+		ReplicationRuleName:    d.Get("replication_rule_name").(string),
+		FileNamePrefix:         d.Get("file_name_prefix").(string),
+		Priority:               d.Get("priority").(int),
+		IncludeExistingFiles:   d.Get("include_existing_files").(bool),
+		IsEnabled:              d.Get("is_enabled").(bool),
+		SourceApplicationKeyId: d.Get("source_application_key_id").(string),
+	}
+
+	var output BucketReplicationOutput
+	err := client.Apply(ctx, OpResourceUpdate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// This field is not returned by the API but is needed for the resource
+	output.ManagedApplicationKeyIds = d.Get("managed_application_key_ids").(*schema.Set).List()
+
+	err = client.Populate(ctx, OpResourceUpdate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketReplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketReplicationInput{
+		SourceBucketId:              d.Get("source_bucket_id").(string),
+		DestinationBucketId:         d.Get("destination_bucket_id").(string),
+		ReplicationRuleName:         d.Get("replication_rule_name").(string),
+		SourceApplicationKeyId:      d.Get("source_application_key_id").(string),
+		DestinationApplicationKeyId: d.Get("destination_application_key_id").(string),
+		ManagedApplicationKeyIds:    d.Get("managed_application_key_ids").(*schema.Set).List(),
+	}
+
+	err := client.Apply(ctx, OpResourceDelete, &input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,274 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_replication_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceB2BucketReplication_basic(t *testing.T) {
+	resourceName := "b2_bucket_replication.test"
+	sourceBucketResourceName := "b2_bucket.source"
+	destinationBucketResourceName := "b2_bucket.destination"
+
+	sourceBucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	destinationBucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketReplicationConfig_basic(sourceBucketName, destinationBucketName, "logs/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "source_bucket_id", sourceBucketResourceName, "bucket_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_bucket_id", destinationBucketResourceName, "bucket_id"),
+					resource.TestCheckResourceAttr(resourceName, "replication_rule_name", "test-rule"),
+					resource.TestCheckResourceAttr(resourceName, "file_name_prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "128"),
+					resource.TestCheckResourceAttr(resourceName, "include_existing_files", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_application_key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_application_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "managed_application_key_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccResourceB2BucketReplicationConfig_basic(sourceBucketName, destinationBucketName, "data/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_name_prefix", "data/"),
+					resource.TestCheckResourceAttr(resourceName, "managed_application_key_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2BucketReplication_sharedKeys(t *testing.T) {
+	resourceName1 := "b2_bucket_replication.test1"
+	resourceName2 := "b2_bucket_replication.test2"
+
+	sourceBucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	destinationBucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketReplicationConfig_sharedKeys(sourceBucketName, destinationBucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName2, "source_application_key_id", resourceName1, "source_application_key_id"),
+					resource.TestCheckResourceAttrPair(resourceName2, "destination_application_key_id", resourceName1, "destination_application_key_id"),
+					resource.TestCheckResourceAttr(resourceName1, "managed_application_key_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName2, "managed_application_key_ids.#", "2"),
+				),
+			},
+			{
+				// The update of the source bucket leaves the remaining rule untouched
+				Config: testAccResourceB2BucketReplicationConfig_sharedKeysRemoved(sourceBucketName, destinationBucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("b2_bucket.source", "bucket_info.%", "1"),
+					resource.TestCheckResourceAttr(resourceName2, "replication_rule_name", "test-rule2"),
+					resource.TestCheckResourceAttr(resourceName2, "managed_application_key_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2BucketReplication_parallel(t *testing.T) {
+	resourceName1 := "b2_bucket_replication.test1"
+	resourceName2 := "b2_bucket_replication.test2"
+
+	sourceBucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	destinationBucketName1 := acctest.RandomWithPrefix("test-b2-tfp")
+	destinationBucketName2 := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// The rules are created in parallel, a lost rule would show up in the plan after the apply
+				Config: testAccResourceB2BucketReplicationConfig_parallel(sourceBucketName, destinationBucketName1, destinationBucketName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName1, "replication_rule_name", "test-rule1"),
+					resource.TestCheckResourceAttr(resourceName2, "replication_rule_name", "test-rule2"),
+					resource.TestCheckResourceAttrPair(resourceName2, "source_application_key_id", resourceName1, "source_application_key_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2BucketReplication_conflictingSourceKey(t *testing.T) {
+	sourceBucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	destinationBucketName := acctest.RandomWithPrefix("test-b2-tfp")
+	keyName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceB2BucketReplicationConfig_conflictingSourceKey(sourceBucketName, destinationBucketName, keyName),
+				ExpectError: regexp.MustCompile(`already replicates with source key`),
+			},
+		},
+	})
+}
+
+func testAccResourceB2BucketReplicationConfig_basic(sourceBucketName string, destinationBucketName string, fileNamePrefix string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "source" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket" "destination" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_replication" "test" {
+  source_bucket_id      = b2_bucket.source.bucket_id
+  destination_bucket_id = b2_bucket.destination.bucket_id
+  replication_rule_name = "test-rule"
+  file_name_prefix      = "%s"
+}
+`, sourceBucketName, destinationBucketName, fileNamePrefix)
+}
+
+func testAccResourceB2BucketReplicationConfig_sharedKeys(sourceBucketName string, destinationBucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "source" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket" "destination" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_replication" "test1" {
+  source_bucket_id      = b2_bucket.source.bucket_id
+  destination_bucket_id = b2_bucket.destination.bucket_id
+  replication_rule_name = "test-rule1"
+  file_name_prefix      = "logs/"
+}
+
+resource "b2_bucket_replication" "test2" {
+  source_bucket_id      = b2_bucket_replication.test1.source_bucket_id
+  destination_bucket_id = b2_bucket_replication.test1.destination_bucket_id
+  replication_rule_name = "test-rule2"
+  file_name_prefix      = "data/"
+}
+`, sourceBucketName, destinationBucketName)
+}
+
+func testAccResourceB2BucketReplicationConfig_sharedKeysRemoved(sourceBucketName string, destinationBucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "source" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+  bucket_info = {
+    description = "replicated"
+  }
+}
+
+resource "b2_bucket" "destination" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_replication" "test2" {
+  source_bucket_id      = b2_bucket.source.bucket_id
+  destination_bucket_id = b2_bucket.destination.bucket_id
+  replication_rule_name = "test-rule2"
+  file_name_prefix      = "data/"
+}
+`, sourceBucketName, destinationBucketName)
+}
+
+func testAccResourceB2BucketReplicationConfig_parallel(sourceBucketName string, destinationBucketName1 string, destinationBucketName2 string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "source" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket" "destination1" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket" "destination2" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_replication" "test1" {
+  source_bucket_id      = b2_bucket.source.bucket_id
+  destination_bucket_id = b2_bucket.destination1.bucket_id
+  replication_rule_name = "test-rule1"
+  file_name_prefix      = "logs/"
+}
+
+resource "b2_bucket_replication" "test2" {
+  source_bucket_id      = b2_bucket.source.bucket_id
+  destination_bucket_id = b2_bucket.destination2.bucket_id
+  replication_rule_name = "test-rule2"
+  file_name_prefix      = "data/"
+}
+`, sourceBucketName, destinationBucketName1, destinationBucketName2)
+}
+
+func testAccResourceB2BucketReplicationConfig_conflictingSourceKey(sourceBucketName string, destinationBucketName string, keyName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "source" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket" "destination" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_application_key" "test" {
+  key_name     = "%s"
+  capabilities = ["readFiles", "readFileLegalHolds", "readFileRetentions"]
+  bucket_ids   = [b2_bucket.source.bucket_id]
+}
+
+resource "b2_bucket_replication" "test1" {
+  source_bucket_id      = b2_bucket.source.bucket_id
+  destination_bucket_id = b2_bucket.destination.bucket_id
+  replication_rule_name = "test-rule1"
+  file_name_prefix      = "logs/"
+}
+
+resource "b2_bucket_replication" "test2" {
+  source_bucket_id          = b2_bucket_replication.test1.source_bucket_id
+  destination_bucket_id     = b2_bucket_replication.test1.destination_bucket_id
+  replication_rule_name     = "test-rule2"
+  file_name_prefix          = "data/"
+  source_application_key_id = b2_application_key.test.application_key_id
+}
+`, sourceBucketName, destinationBucketName, keyName)
+}
@@ -0,0 +1,115 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_unfinished_large_files_cleanup.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceB2BucketUnfinishedLargeFilesCleanup() *schema.Resource {
+	return &schema.Resource{
+		Description: "B2 bucket unfinished large files cleanup resource. Cancels the matching unfinished large files when created," +
+			" and again whenever it is replaced, for example by changing `keepers`. Destroying the resource does not do anything.",
+
+		CreateContext: resourceB2BucketUnfinishedLargeFilesCleanupCreate,
+		ReadContext:   resourceB2BucketUnfinishedLargeFilesCleanupRead,
+		DeleteContext: resourceB2BucketUnfinishedLargeFilesCleanupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Description:  "The ID of the bucket.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name_prefix": {
+				Description: "When provided, only the files whose names start with the prefix are canceled.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"older_than": {
+				Description: "Only the files whose upload was started longer ago than the given duration, such as \"168h\", are canceled." +
+					" Defaults to 24 hours, so that the uploads still in progress are not canceled.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "24h",
+				ValidateFunc: validateDuration,
+			},
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger the cancellation of the matching files again.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				ForceNew: true,
+			},
+			"canceled_files": {
+				Description: "The unfinished large files that have been canceled.",
+				Type:        schema.TypeList,
+				Elem:        getUnfinishedLargeFileElem(),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceB2BucketUnfinishedLargeFilesCleanupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	input := BucketUnfinishedLargeFilesCleanupInput{
+		BucketId:      d.Get("bucket_id").(string),
+		NamePrefix:    d.Get("name_prefix").(string),
+		OlderThan:     d.Get("older_than").(string),
+		Keepers:       d.Get("keepers").(map[string]interface{}),
+		StartedBefore: startedBefore(d.Get("older_than").(string)),
+	}
+
+	var output BucketUnfinishedLargeFilesCleanupOutput
+	err := client.Apply(ctx, OpResourceCreate, &input, &output)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// These fields are not returned by the API but are needed for the resource
+	output.BucketUnfinishedLargeFilesCleanupInput = input
+	for i := range output.CanceledFiles {
+		output.CanceledFiles[i].StartTime = formatTimestamp(output.CanceledFiles[i].UploadTimestamp)
+	}
+
+	d.SetId(output.Sha1)
+
+	err = client.Populate(ctx, OpResourceCreate, &output, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceB2BucketUnfinishedLargeFilesCleanupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The cleanup has no remote state, canceled files stay canceled
+	return nil
+}
+
+func resourceB2BucketUnfinishedLargeFilesCleanupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Canceled files cannot be restored
+	d.SetId("")
+
+	return nil
+}
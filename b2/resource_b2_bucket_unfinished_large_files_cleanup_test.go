@@ -0,0 +1,149 @@
+//####################################################################
+//
+// File: b2/resource_b2_bucket_unfinished_large_files_cleanup_test.go
+//
+// Copyright 2026 Backblaze Inc. All Rights Reserved.
+//
+// License https://www.backblaze.com/using_b2_code.html
+//
+//####################################################################
+
+package b2
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceB2BucketUnfinishedLargeFilesCleanup_basic(t *testing.T) {
+	parentResourceName := "b2_bucket.test"
+	resourceName := "b2_bucket_unfinished_large_files_cleanup.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketUnfinishedLargeFilesCleanupConfig_basic(bucketName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket_id", parentResourceName, "bucket_id"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "temp/"),
+					resource.TestCheckResourceAttr(resourceName, "older_than", "24h"),
+					resource.TestCheckResourceAttr(resourceName, "keepers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "keepers.run", "1"),
+					resource.TestCheckResourceAttr(resourceName, "canceled_files.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceB2BucketUnfinishedLargeFilesCleanupConfig_basic(bucketName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keepers.run", "2"),
+					resource.TestCheckResourceAttr(resourceName, "canceled_files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceB2BucketUnfinishedLargeFilesCleanup_started(t *testing.T) {
+	resourceName := "b2_bucket_unfinished_large_files_cleanup.test"
+
+	bucketName := acctest.RandomWithPrefix("test-b2-tfp")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceB2BucketUnfinishedLargeFilesCleanupConfig_bucket(bucketName),
+			},
+			{
+				PreConfig: func() { testAccStartLargeFile(t, bucketName, "temp/large.bin", 1) },
+				Config:    testAccResourceB2BucketUnfinishedLargeFilesCleanupConfig_started(bucketName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "older_than", "24h"),
+					resource.TestCheckResourceAttr(resourceName, "canceled_files.#", "0"),
+				),
+			},
+			{
+				// The file has been started at least a second ago, in the previous step
+				Config: testAccResourceB2BucketUnfinishedLargeFilesCleanupConfig_started(bucketName, `older_than = "1s"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "canceled_files.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "canceled_files.0.file_id"),
+					resource.TestCheckResourceAttr(resourceName, "canceled_files.0.file_name", "temp/large.bin"),
+					resource.TestCheckResourceAttrSet(resourceName, "canceled_files.0.start_time"),
+					resource.TestCheckResourceAttr(resourceName, "canceled_files.0.part_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "canceled_files.0.uploaded_bytes", strconv.Itoa(testAccLargeFilePartSize)),
+				),
+			},
+			{
+				Config: testAccResourceB2BucketUnfinishedLargeFilesCleanupConfig_canceled(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.b2_bucket_unfinished_large_files.test", "files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceB2BucketUnfinishedLargeFilesCleanupConfig_basic(bucketName string, run string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_unfinished_large_files_cleanup" "test" {
+  bucket_id = b2_bucket.test.id
+  name_prefix = "temp/"
+  older_than = "24h"
+  keepers = {
+    run = "%s"
+  }
+}
+`, bucketName, run)
+}
+
+func testAccResourceB2BucketUnfinishedLargeFilesCleanupConfig_bucket(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+`, bucketName)
+}
+
+func testAccResourceB2BucketUnfinishedLargeFilesCleanupConfig_started(bucketName string, olderThan string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+resource "b2_bucket_unfinished_large_files_cleanup" "test" {
+  bucket_id = b2_bucket.test.id
+  name_prefix = "temp/"
+  %s
+}
+`, bucketName, olderThan)
+}
+
+func testAccResourceB2BucketUnfinishedLargeFilesCleanupConfig_canceled(bucketName string) string {
+	return fmt.Sprintf(`
+resource "b2_bucket" "test" {
+  bucket_name = "%s"
+  bucket_type = "allPrivate"
+}
+
+data "b2_bucket_unfinished_large_files" "test" {
+  bucket_id = b2_bucket.test.id
+}
+`, bucketName)
+}
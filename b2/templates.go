@@ -56,6 +56,27 @@ func getDataSourceFileVersionsElem() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"file_retention": {
+				Description: "File retention settings. Empty when the file has no retention, and when the application key is not allowed to read it, see `file_retention_readable`.",
+				Type:        schema.TypeList,
+				Elem:        getFileRetentionElem(true),
+				Computed:    true,
+			},
+			"file_retention_readable": {
+				Description: "Whether the application key is allowed to read the file retention settings.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"legal_hold": {
+				Description: "Legal hold status (on|off). Empty when the legal hold has never been set, and when the application key is not allowed to read it, see `legal_hold_readable`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"legal_hold_readable": {
+				Description: "Whether the application key is allowed to read the legal hold status.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
 			"size": {
 				Description: "The file size.",
 				Type:        schema.TypeInt,
@@ -72,6 +93,26 @@ func getDataSourceFileVersionsElem() *schema.Resource {
 				Type:        schema.TypeInt,
 				Computed:    true,
 			},
+			"replication_status": {
+				Description: "Replication status of the file (pending|completed|failed|replica). Empty when the file is not replicated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"sse_c_key_id": {
+				Description: "Identifier of the key used in SSE-C mode, from the file info.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"src_last_modified_millis": {
+				Description: "Last modification time of the source file, in milliseconds since 1970, from the file info. 0 when the file info does not have it.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"large_file_sha1": {
+				Description: "SHA1 hash of the content of a large file, from the file info.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"bucket_id": {
 				Description: "The ID of the bucket.",
 				Type:        schema.TypeString,
@@ -180,6 +221,27 @@ func getResourceFileEncryptionElem() *schema.Resource {
 	}
 }
 
+func getFileRetentionElem(ds bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"mode": {
+				Description:  "File retention mode (compliance|governance).",
+				Type:         schema.TypeString,
+				Computed:     If(ds, true, false),
+				Required:     If(ds, false, true),
+				ValidateFunc: If(ds, nil, validation.StringInSlice([]string{"compliance", "governance"}, false)),
+			},
+			"retain_until_timestamp": {
+				Description:  "Until when the file is immutable, in milliseconds since 1970.",
+				Type:         schema.TypeInt,
+				Computed:     If(ds, true, false),
+				Required:     If(ds, false, true),
+				ValidateFunc: If(ds, nil, validation.IntAtLeast(1)),
+			},
+		},
+	}
+}
+
 func getServerSideEncryptionElem(ds bool) *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -358,6 +420,123 @@ func getLifecycleRulesElem(ds bool) *schema.Resource {
 	}
 }
 
+func getReplicationConfigurationElem(ds bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"as_replication_source": {
+				Description: "Replication settings of the bucket as a replication source.",
+				Type:        schema.TypeList,
+				Computed:    If(ds, true, false),
+				Optional:    If(ds, false, true),
+				MaxItems:    If(ds, 0, 1),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"replication_rules": {
+							Description: "The list of replication rules.",
+							Type:        schema.TypeList,
+							Computed:    If(ds, true, false),
+							Required:    If(ds, false, true),
+							MinItems:    If(ds, 0, 1),
+							Elem:        getReplicationRuleElem(ds),
+						},
+						"source_application_key_id": {
+							Description:  "The ID of the application key used to read the files of the source bucket.",
+							Type:         schema.TypeString,
+							Computed:     If(ds, true, false),
+							Required:     If(ds, false, true),
+							ValidateFunc: If(ds, nil, validation.NoZeroValues),
+						},
+					},
+				},
+			},
+			"as_replication_destination": {
+				Description: "Replication settings of the bucket as a replication destination.",
+				Type:        schema.TypeList,
+				Computed:    If(ds, true, false),
+				Optional:    If(ds, false, true),
+				MaxItems:    If(ds, 0, 1),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_to_destination_key_mapping": {
+							Description: "A map of source application key IDs to the destination application key IDs used to write the replicated files.",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: If(ds, true, false),
+							Required: If(ds, false, true),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getReplicationRuleElem(ds bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"replication_rule_name": {
+				Description:  "A name for the replication rule. The name must be unique among the bucket's replication rules.",
+				Type:         schema.TypeString,
+				Computed:     If(ds, true, false),
+				Required:     If(ds, false, true),
+				ValidateFunc: If(ds, nil, validateReplicationRuleName),
+			},
+			"destination_bucket_id": {
+				Description:  "The ID of the destination bucket.",
+				Type:         schema.TypeString,
+				Computed:     If(ds, true, false),
+				Required:     If(ds, false, true),
+				ValidateFunc: If(ds, nil, validation.NoZeroValues),
+			},
+			"file_name_prefix": {
+				Description: "Only files whose names start with the prefix are replicated.",
+				Type:        schema.TypeString,
+				Computed:    If(ds, true, false),
+				Optional:    If(ds, false, true),
+			},
+			"priority": {
+				Description: "The priority of the rule, used when several rules match a file. Higher values take precedence.",
+				Type:        schema.TypeInt,
+				Computed:    If(ds, true, false),
+				Optional:    If(ds, false, true),
+				DefaultFunc: If(ds,
+					nil,
+					func() (any, error) { return 128, nil },
+				),
+				ValidateFunc: If(ds, nil, validation.IntBetween(1, 2147483647)),
+			},
+			"include_existing_files": {
+				Description: "Whether files uploaded before the rule was created are replicated too.",
+				Type:        schema.TypeBool,
+				Computed:    If(ds, true, false),
+				Optional:    If(ds, false, true),
+			},
+			"is_enabled": {
+				Description: "Whether the replication rule is enabled.",
+				Type:        schema.TypeBool,
+				Computed:    If(ds, true, false),
+				Optional:    If(ds, false, true),
+				DefaultFunc: If(ds,
+					nil,
+					func() (any, error) { return true, nil },
+				),
+			},
+		},
+	}
+}
+
+// notificationEventTypes lists the event types supported by notification rules,
+// including the wildcards matching all the events of a category.
+var notificationEventTypes = []string{
+	"b2:ObjectCreated:*", "b2:ObjectCreated:Upload", "b2:ObjectCreated:MultipartUpload",
+	"b2:ObjectCreated:Copy", "b2:ObjectCreated:Replica", "b2:ObjectCreated:MultipartReplica",
+	"b2:ObjectDeleted:*", "b2:ObjectDeleted:Delete", "b2:ObjectDeleted:LifecycleRule",
+	"b2:HideMarkerCreated:*", "b2:HideMarkerCreated:Hide", "b2:HideMarkerCreated:LifecycleRule",
+	"b2:MultipartUploadCreated:*", "b2:MultipartUploadCreated:LiveRead",
+}
+
 func getNotificationRulesElem(ds bool) *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -368,13 +547,7 @@ func getNotificationRulesElem(ds bool) *schema.Resource {
 					Type: schema.TypeString,
 					ValidateFunc: If(ds,
 						nil,
-						validation.StringInSlice([]string{
-							"b2:ObjectCreated:*", "b2:ObjectCreated:Upload", "b2:ObjectCreated:MultipartUpload",
-							"b2:ObjectCreated:Copy", "b2:ObjectCreated:Replica", "b2:ObjectCreated:MultipartReplica",
-							"b2:ObjectDeleted:*", "b2:ObjectDeleted:Delete", "b2:ObjectDeleted:LifecycleRule",
-							"b2:HideMarkerCreated:*", "b2:HideMarkerCreated:Hide", "b2:HideMarkerCreated:LifecycleRule",
-							"b2:MultipartUploadCreated:*", "b2:MultipartUploadCreated:LiveRead",
-						}, false),
+						validation.StringInSlice(notificationEventTypes, false),
 					),
 				},
 				Computed: If(ds, true, false),
@@ -407,71 +580,138 @@ func getNotificationRulesElem(ds bool) *schema.Resource {
 			"target_configuration": {
 				Description: "The target configuration for the event notification rule.",
 				Type:        schema.TypeList,
+				Elem:        getTargetConfigurationElem(ds),
+				Computed:    If(ds, true, false),
+				Required:    If(ds, false, true),
+				MaxItems:    If(ds, 0, 1),
+			},
+			"is_suspended": {
+				Description: "Whether the event notification rule is suspended.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"suspension_reason": {
+				Description: "A brief description of why the event notification rule was suspended.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getTargetConfigurationElem(ds bool) *schema.Resource {
+	elem := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"target_type": {
+				Description:  "The type of the target configuration, currently \"webhook\" only.",
+				Type:         schema.TypeString,
+				Computed:     If(ds, true, false),
+				Required:     If(ds, false, true),
+				ValidateFunc: If(ds, nil, validation.StringInSlice([]string{"webhook"}, false)),
+			},
+			"url": {
+				Description:  "The URL for the webhook.",
+				Type:         schema.TypeString,
+				Computed:     If(ds, true, false),
+				Required:     If(ds, false, true),
+				ValidateFunc: If(ds, nil, validation.IsURLWithHTTPS),
+			},
+			"custom_headers": {
+				Description: "When present, additional header name/value pairs to be sent on the webhook invocation.",
+				Type:        schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"target_type": {
-							Description:  "The type of the target configuration, currently \"webhook\" only.",
+						"name": {
+							Description:  "Name of the header.",
 							Type:         schema.TypeString,
 							Computed:     If(ds, true, false),
 							Required:     If(ds, false, true),
-							ValidateFunc: If(ds, nil, validation.StringInSlice([]string{"webhook"}, false)),
+							ValidateFunc: If(ds, nil, validation.NoZeroValues),
 						},
-						"url": {
-							Description:  "The URL for the webhook.",
+						"value": {
+							Description:  "Value of the header.",
 							Type:         schema.TypeString,
 							Computed:     If(ds, true, false),
 							Required:     If(ds, false, true),
-							ValidateFunc: If(ds, nil, validation.IsURLWithHTTPS),
-						},
-						"custom_headers": {
-							Description: "When present, additional header name/value pairs to be sent on the webhook invocation.",
-							Type:        schema.TypeList,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Description:  "Name of the header.",
-										Type:         schema.TypeString,
-										Computed:     If(ds, true, false),
-										Required:     If(ds, false, true),
-										ValidateFunc: If(ds, nil, validation.NoZeroValues),
-									},
-									"value": {
-										Description:  "Value of the header.",
-										Type:         schema.TypeString,
-										Computed:     If(ds, true, false),
-										Required:     If(ds, false, true),
-										ValidateFunc: If(ds, nil, validation.NoZeroValues),
-									},
-								},
-							},
-							Computed: If(ds, true, false),
-							Optional: If(ds, false, true),
-							MaxItems: If(ds, 0, 10),
-						},
-						"hmac_sha256_signing_secret": {
-							Description:  "The signing secret for use in verifying the X-Bz-Event-Notification-Signature.",
-							Type:         schema.TypeString,
-							Sensitive:    true,
-							Computed:     If(ds, true, false),
-							Optional:     If(ds, false, true),
-							ValidateFunc: If(ds, nil, StringLenExact(32)),
+							ValidateFunc: If(ds, nil, validation.NoZeroValues),
 						},
 					},
 				},
 				Computed: If(ds, true, false),
-				Required: If(ds, false, true),
-				MaxItems: If(ds, 0, 1),
+				Optional: If(ds, false, true),
+				MaxItems: If(ds, 0, 10),
 			},
-			"is_suspended": {
-				Description: "Whether the event notification rule is suspended.",
-				Type:        schema.TypeBool,
+			"hmac_sha256_signing_secret": {
+				Description:  "The signing secret for use in verifying the X-Bz-Event-Notification-Signature.",
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				Computed:     If(ds, true, false),
+				Optional:     If(ds, false, true),
+				ValidateFunc: If(ds, nil, StringLenExact(32)),
+			},
+		},
+	}
+	if !ds {
+		elem.Schema["generate_signing_secret"] = &schema.Schema{
+			Description: "Generate a random signing secret instead of setting `hmac_sha256_signing_secret`. The secret is generated once, during the plan, and stored in `generated_signing_secrets` of the resource.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		}
+		elem.Schema["signing_secret_version"] = &schema.Schema{
+			Description: "An arbitrary value; changing it rotates the generated signing secret.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		}
+	}
+	return elem
+}
+
+func getUnfinishedLargeFileElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"file_id": {
+				Description: "The unique identifier of the unfinished large file.",
+				Type:        schema.TypeString,
 				Computed:    true,
 			},
-			"suspension_reason": {
-				Description: "A brief description of why the event notification rule was suspended.",
+			"file_name": {
+				Description: "The name of the B2 file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"content_type": {
+				Description: "Content type of the file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"file_info": {
+				Description: "The custom information that is uploaded with the file.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"upload_timestamp": {
+				Description: "When the upload of the large file was started, in milliseconds since 1970.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"start_time": {
+				Description: "When the upload of the large file was started, as an RFC 3339 timestamp.",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"part_count": {
+				Description: "The number of parts uploaded.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"uploaded_bytes": {
+				Description: "The total size of the parts uploaded.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 		},
 	}
 }
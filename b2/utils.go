@@ -10,9 +10,89 @@
 
 package b2
 
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
 func If[T any](cond bool, vtrue, vfalse T) T {
 	if cond {
 		return vtrue
 	}
 	return vfalse
 }
+
+// importBucketRuleState returns an importer for resources identified by
+// "<bucket_id>/<rule key>", which sets bucket_id and the given key attribute.
+func importBucketRuleState(key string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		bucketId, value, ok := strings.Cut(d.Id(), "/")
+		if !ok || bucketId == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%s), expected <bucket_id>/<%s>", d.Id(), key)
+		}
+		if err := d.Set("bucket_id", bucketId); err != nil {
+			return nil, err
+		}
+		if err := d.Set(key, value); err != nil {
+			return nil, err
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// keyExpiryWarnings warns about an application key that has expired or
+// expires within the given number of days.
+func keyExpiryWarnings(warningDays int, applicationKeyId string, expirationTimestamp int) diag.Diagnostics {
+	if expirationTimestamp == 0 {
+		return nil
+	}
+	expiration := time.UnixMilli(int64(expirationTimestamp))
+	if !time.Now().Before(expiration) {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "Application key expired",
+				Detail:   fmt.Sprintf("Application key %s expired at %s.", applicationKeyId, formatTimestamp(expirationTimestamp)),
+			},
+		}
+	}
+	if warningDays > 0 && time.Until(expiration) < time.Duration(warningDays)*24*time.Hour {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "Application key expires soon",
+				Detail: fmt.Sprintf("Application key %s expires at %s, within %d days.",
+					applicationKeyId, formatTimestamp(expirationTimestamp), warningDays),
+			},
+		}
+	}
+	return nil
+}
+
+// parseTimestamp parses an RFC 3339 timestamp or a number of milliseconds since 1970,
+// and returns the number of milliseconds since 1970.
+func parseTimestamp(value string) (int64, error) {
+	if millis, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return millis, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, fmt.Errorf("expected an RFC 3339 timestamp or milliseconds since 1970, got %s", value)
+	}
+	return t.UnixMilli(), nil
+}
+
+// formatTimestamp formats milliseconds since 1970 as an RFC 3339 timestamp,
+// or returns an empty string when the timestamp is not set.
+func formatTimestamp(timestamp int) string {
+	if timestamp == 0 {
+		return ""
+	}
+	return time.UnixMilli(int64(timestamp)).UTC().Format(time.RFC3339)
+}
@@ -13,9 +13,49 @@ package b2
 import (
 	"encoding/base64"
 	"fmt"
+	"regexp"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validateReplicationRuleName = validation.StringMatch(
+	regexp.MustCompile(`^[a-zA-Z0-9-]{2,64}$`),
+	"must be 2 to 64 characters long and contain only letters, numbers and dashes",
 )
 
+func validateDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be a duration such as \"720h\", got %s: %s", k, v, err))
+	} else if duration <= 0 {
+		errors = append(errors, fmt.Errorf("expected %s to be a positive duration, got %s", k, v))
+	}
+
+	return warnings, errors
+}
+
+func validateTimestamp(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := parseTimestamp(v); err != nil {
+		errors = append(errors, fmt.Errorf("invalid %s: %s", k, err))
+	}
+
+	return warnings, errors
+}
+
 func validateBase64Key(i interface{}, k string) (warnings []string, errors []error) {
 	v, ok := i.(string)
 	if ok {
@@ -36,6 +76,24 @@ func validateBase64Key(i interface{}, k string) (warnings []string, errors []err
 	return warnings, errors
 }
 
+// validateKeyRotation validates a key rotation setting, and reminds that the keys are
+// only replaced without downtime with the create_before_destroy lifecycle setting,
+// which the resource cannot default to.
+//
+//nolint:staticcheck // Using SchemaValidateFunc for backward compatibility; migrate to SchemaValidateDiagFunc later.
+func validateKeyRotation(validate schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		if validate != nil {
+			warnings, errors = validate(i, k)
+		}
+		if len(errors) == 0 {
+			warnings = append(warnings, fmt.Sprintf("%s makes the key be replaced; set `lifecycle { create_before_destroy = true }`"+
+				" so that the new key is created before the old one is deleted", k))
+		}
+		return warnings, errors
+	}
+}
+
 // StringLenExact returns a SchemaValidateFunc which tests if the provided value
 // is of type string and has given length
 //
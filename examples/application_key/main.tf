@@ -15,6 +15,20 @@ resource "b2_application_key" "example" {
   capabilities = ["readFiles"]
 }
 
+# B2 keys cannot be updated, so the rotated key is replaced; the resource cannot
+# default to create_before_destroy, so set it to create the new key first
+resource "b2_application_key" "rotated" {
+  key_name                  = "test-b2-tfp-0000000000000000001"
+  capabilities              = ["readFiles"]
+  valid_duration_in_seconds = 7776000
+  rotation_period           = "720h"
+  rotate_before_expiry      = "168h"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
 data "b2_application_key" "example" {
   key_name = b2_application_key.example.key_name
 }
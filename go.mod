@@ -6,10 +6,12 @@ toolchain go1.25.11
 
 require github.com/hashicorp/terraform-plugin-log v0.9.0
 
-require github.com/hashicorp/terraform-plugin-sdk/v2 v2.36.1
+require (
+	github.com/ProtonMail/go-crypto v1.1.3
+	github.com/hashicorp/terraform-plugin-sdk/v2 v2.36.1
+)
 
 require (
-	github.com/ProtonMail/go-crypto v1.1.3 // indirect
 	github.com/agext/levenshtein v1.2.2 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/cloudflare/circl v1.6.3 // indirect
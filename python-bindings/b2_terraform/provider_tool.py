@@ -9,10 +9,14 @@
 ######################################################################
 
 import base64
+import io
 import json
 import hashlib
+import re
 import sys
+import time
 import traceback
+from datetime import datetime
 from functools import cached_property
 
 from class_registry import ClassRegistry
@@ -25,9 +29,20 @@ from b2sdk.v3 import (
     EncryptionKey,
     EncryptionMode,
     EncryptionSetting,
+    FileRetentionSetting,
     InMemoryAccountInfo,
+    LegalHold,
+    ReplicationConfiguration,
+    ReplicationRule,
+    RetentionMode,
+)
+from b2sdk.v3.exception import (
+    B2Error,
+    BadRequest,
+    BucketIdNotFound,
+    FileNotPresent,
+    NonExistentBucket,
 )
-from b2sdk.v3.exception import BadRequest, BucketIdNotFound
 from b2_terraform.arg_parser import ArgumentParser
 from b2_terraform.json_encoder import B2ProviderJsonEncoder
 
@@ -40,6 +55,144 @@ def apply_or_none(func, value):
     return None if value is None else func(value)
 
 
+def file_retention_from_config(file_retention):
+    if not file_retention:
+        return None
+    return FileRetentionSetting(
+        mode=RetentionMode(file_retention[0]['mode']),
+        retain_until=file_retention[0]['retain_until_timestamp'],
+    )
+
+
+def update_bucket_with_revision(api, bucket_id, get_params, max_attempts=5):
+    """
+    Read-modify-write of a bucket. `get_params` computes `Bucket.update` params from the current bucket;
+    the update only succeeds if nobody changed the bucket in the meantime, otherwise it is retried.
+    """
+    for _ in range(max_attempts):
+        bucket = api.get_bucket_by_id(bucket_id)
+        params = get_params(bucket)
+        try:
+            return bucket.update(if_revision_is=bucket.revision, **params)
+        except B2Error:
+            if api.get_bucket_by_id(bucket_id).revision == bucket.revision:
+                raise  # not a revision conflict
+    raise RuntimeError(
+        f'bucket {bucket_id} was modified concurrently {max_attempts} times, giving up on updating it'
+    )
+
+
+# the inputs also hold the provider credentials, only the rule fields may be sent to B2
+CORS_RULE_FIELDS = (
+    'cors_rule_name',
+    'allowed_origins',
+    'allowed_operations',
+    'allowed_headers',
+    'expose_headers',
+    'max_age_seconds',
+)
+LIFECYCLE_RULE_FIELDS = (
+    'file_name_prefix',
+    'days_from_hiding_to_deleting',
+    'days_from_uploading_to_hiding',
+    'days_from_starting_to_canceling_unfinished_large_files',
+)
+
+
+def cors_rule_from_config(cors_rule):
+    cors_rule = {key: cors_rule.get(key) for key in CORS_RULE_FIELDS}
+    return change_keys(cors_rule, converter=camelize)
+
+
+def lifecycle_rule_from_config(lifecycle_rule):
+    lifecycle_rule = {key: lifecycle_rule.get(key) for key in LIFECYCLE_RULE_FIELDS}
+    # B2 expects null instead of 0 days
+    for key in LIFECYCLE_RULE_FIELDS[1:]:
+        if lifecycle_rule[key] == 0:
+            lifecycle_rule[key] = None
+    return change_keys(lifecycle_rule, converter=camelize)
+
+
+def cors_rule_names(cors_rules):
+    # the rules may come either from the Terraform config or from the B2 API
+    return {
+        change_keys(rule, converter=decamelize)['cors_rule_name'] for rule in cors_rules or ()
+    }
+
+
+def lifecycle_rule_prefixes(lifecycle_rules):
+    # the rules may come either from the Terraform config or from the B2 API
+    return {
+        change_keys(rule, converter=decamelize)['file_name_prefix']
+        for rule in lifecycle_rules or ()
+    }
+
+
+def order_allowed_operations(cors_rules, config_cors_rules):
+    # B2 does not necessarily return allowed_operations in the same order as they were set.
+    # This can cause unnecessary diffs in the Terraform state.
+    # In order to avoid this, we sort the allowed_operations in the same order as they were set.
+    for cors_rules_item, config_cors_rules_item in zip(cors_rules, config_cors_rules):
+        allowed_operations = cors_rules_item.get('allowedOperations', [])
+        config_allowed_operations = (
+            config_cors_rules_item.get('allowedOperations')
+            or config_cors_rules_item.get('allowed_operations')
+            or []
+        )
+        if allowed_operations:
+
+            def sort_key(allowed_operation):
+                try:
+                    return config_allowed_operations.index(allowed_operation)
+                except ValueError:
+                    return -1
+
+            allowed_operations.sort(key=sort_key)
+
+
+def file_version_as_dict(file_version):
+    result = file_version.as_dict()
+    # file versions without retention are returned with an empty retention setting,
+    # and the settings the application key is not allowed to read are unknown
+    file_retention = result.get('fileRetention')
+    result['fileRetentionReadable'] = not file_retention or file_retention.get('mode') != 'unknown'
+    if not file_retention or file_retention.get('mode') in (None, 'unknown'):
+        result['fileRetention'] = None
+    result['legalHoldReadable'] = result.get('legalHold') != 'unknown'
+    if result.get('legalHold') == 'unknown':
+        result['legalHold'] = None
+    # b2sdk uses upper-case replication statuses
+    replication_status = file_version.replication_status
+    result['replicationStatus'] = replication_status and replication_status.value.lower()
+    file_info = file_version.file_info or {}
+    result['sseCKeyId'] = file_info.get('sse_c_key_id')
+    result['srcLastModifiedMillis'] = apply_or_none(int, file_info.get('src_last_modified_millis'))
+    result['largeFileSha1'] = file_info.get('large_file_sha1')
+    return result
+
+
+def glob_to_regex(glob):
+    # "*" and "?" do not match "/", while "**" matches any number of characters, including "/",
+    # and "**/" matches any number of folders, including none
+    parts = []
+    i = 0
+    while i < len(glob):
+        if glob.startswith('**/', i):
+            parts.append('(.*/)?')
+            i += 3
+        elif glob.startswith('**', i):
+            parts.append('.*')
+            i += 2
+        else:
+            parts.append({'*': '[^/]*', '?': '[^/]'}.get(glob[i]) or re.escape(glob[i]))
+            i += 1
+    return re.compile(''.join(parts), re.DOTALL)
+
+
+def timestamp_millis(value):
+    return int(datetime.fromisoformat(value).timestamp() * 1000)
+
+
 class Command:
     # The registry for the subcommands, should be reinitialized  in subclass
     subcommands_registry = None
@@ -140,14 +293,29 @@ class B2Provider(Command):
 
 @B2Provider.register_subcommand
 class ApplicationKey(Command):
-    def data_source_read(self, *, key_name, **kwargs):
-        next_id = None
-        response = self.api.list_keys(next_id)
-        for key in response:
-            if key_name == key.key_name:
-                return self._postprocess(key)
-
-        raise RuntimeError(f'Could not find Application Key for "{key_name}"')
+    def data_source_read(self, *, key_name, application_key_id, fail_if_not_found, **kwargs):
+        if application_key_id:
+            for key in self.api.list_keys(application_key_id):
+                if application_key_id == key.id_:
+                    return self._postprocess(key)
+                break
+
+            if not fail_if_not_found:
+                return None  # no application key has been found
+            raise RuntimeError(f'Could not find Application Key with ID "{application_key_id}"')
+
+        keys = [key for key in self.api.list_keys() if key_name == key.key_name]
+        if not keys:
+            if not fail_if_not_found:
+                return None  # no application key has been found
+            raise RuntimeError(f'Could not find Application Key for "{key_name}"')
+        if len(keys) > 1:
+            key_ids = ', '.join(key.id_ for key in keys)
+            raise RuntimeError(
+                f'Found {len(keys)} Application Keys for "{key_name}" ({key_ids}), '
+                'use application_key_id to select one'
+            )
+        return self._postprocess(keys[0])
 
     def resource_create(self, *, apiver=None, **kwargs):
         if not apiver or apiver == 'v3':
@@ -163,10 +331,15 @@ class ApplicationKey(Command):
         key_name,
         capabilities,
         bucket_ids,
+        bucket_names,
         name_prefix,
         valid_duration_in_seconds,
         **kwargs,
     ):
+        if bucket_names:
+            bucket_ids = [
+                self.api.get_bucket_by_name(bucket_name).id_ for bucket_name in bucket_names
+            ]
         key = self.api.create_key(
             key_name=key_name,
             capabilities=capabilities,
@@ -188,16 +361,42 @@ class ApplicationKey(Command):
         )
         return self._postprocess(key)
 
-    def resource_read(self, *, application_key_id, **kwargs):
+    def resource_read(
+        self, *, application_key_id, application_key, bucket_names, provider_endpoint, **kwargs
+    ):
+        if application_key:
+            self._verify_application_key(application_key_id, application_key, provider_endpoint)
+
         next_id = application_key_id
         response = self.api.list_keys(next_id)
 
         for key in response:
             if application_key_id == key.id_:
-                return self._postprocess(key)
+                return self._postprocess(
+                    key, bucketNames=self._resolved_bucket_names(key, bucket_names)
+                )
 
         return None  # no application key has been found
 
+    def _verify_application_key(self, application_key_id, application_key, endpoint):
+        api = B2Api(account_info=InMemoryAccountInfo())
+        try:
+            api.authorize_account(application_key_id, application_key, endpoint)
+        except B2Error as e:
+            raise RuntimeError(
+                f'Could not authorize with Application Key "{application_key_id}": {e}'
+            ) from e
+
+    def _resolved_bucket_names(self, key, bucket_names):
+        # Keep only the names that still resolve to the buckets of the key,
+        # so that a bucket recreated under the same name shows up as drift
+        resolved = []
+        for bucket_name in bucket_names:
+            buckets = self.api.list_buckets(bucket_name=bucket_name)
+            if buckets and buckets[0].id_ in (key.bucket_ids or []):
+                resolved.append(bucket_name)
+        return resolved
+
     def resource_delete(self, *, application_key_id, **kwargs):
         self.api.delete_key_by_id(application_key_id=application_key_id)
 
@@ -211,11 +410,30 @@ class ApplicationKey(Command):
         return kwargs
 
 
+@B2Provider.register_subcommand
+class ApplicationKeys(Command):
+    def data_source_read(self, **kwargs):
+        # The keys are filtered by the provider
+        key_command = ApplicationKey(self.provider_tool)
+        keys = sorted(self.api.list_keys(), key=lambda key: key.key_name)
+        return self._postprocess(
+            keys=[key_command._postprocess(key) for key in keys],
+        )
+
+
 @B2Provider.register_subcommand
 class Bucket(Command):
-    def data_source_read(self, *, bucket_name, **kwargs):
+    def data_source_read(self, *, bucket_name, bucket_id, fail_if_not_found, **kwargs):
         config_cors_rules = kwargs.get('cors_rules')
-        bucket = self.api.get_bucket_by_name(bucket_name)
+        try:
+            if bucket_id:
+                bucket = self.api.get_bucket_by_id(bucket_id)
+            else:
+                bucket = self.api.get_bucket_by_name(bucket_name)
+        except (BucketIdNotFound, NonExistentBucket):
+            if fail_if_not_found:
+                raise
+            return None  # no bucket has been found
         return self._postprocess(bucket, config_cors_rules=config_cors_rules)
 
     def resource_create(
@@ -228,6 +446,7 @@ class Bucket(Command):
         file_lock_configuration,
         default_server_side_encryption,
         lifecycle_rules,
+        replication_configuration,
         **kwargs,
     ):
         params = self._preprocess(
@@ -238,6 +457,7 @@ class Bucket(Command):
             file_lock_configuration=file_lock_configuration,
             default_server_side_encryption=default_server_side_encryption,
             lifecycle_rules=lifecycle_rules,
+            replication_configuration=replication_configuration,
         )
         # default retention (in file_lock_configuration) can only be set with update_bucket, not create_bucket :(
         default_retention = params.pop('default_retention', None)
@@ -259,12 +479,19 @@ class Bucket(Command):
 
         return self._postprocess(bucket, config_cors_rules=cors_rules)
 
-    def resource_read(self, *, bucket_id, **kwargs):
+    def resource_read(
+        self, *, bucket_id, cors_rules, lifecycle_rules, ignore_external_rules, **kwargs
+    ):
         try:
             bucket = self.api.get_bucket_by_id(bucket_id)
         except BucketIdNotFound:
             return None  # no bucket has been found
-        return self._postprocess(bucket, config_cors_rules=kwargs.get('cors_rules'))
+        return self._postprocess(
+            bucket,
+            config_cors_rules=cors_rules,
+            config_lifecycle_rules=lifecycle_rules,
+            ignore_external_rules=ignore_external_rules,
+        )
 
     def resource_update(
         self,
@@ -276,6 +503,10 @@ class Bucket(Command):
         file_lock_configuration,
         default_server_side_encryption,
         lifecycle_rules,
+        replication_configuration,
+        ignore_external_rules,
+        previous_cors_rules,
+        previous_lifecycle_rules,
         **kwargs,
     ):
         params = self._preprocess(
@@ -287,11 +518,52 @@ class Bucket(Command):
             file_lock_configuration=file_lock_configuration,
             default_server_side_encryption=default_server_side_encryption,
             lifecycle_rules=lifecycle_rules,
+            replication_configuration=replication_configuration,
         )
         params.pop('is_file_lock_enabled', None)  # this can only be set during bucket creation
-        self.api.session.update_bucket(**params)
-        bucket = self.api.get_bucket_by_id(bucket_id)
-        return self._postprocess(bucket, config_cors_rules=cors_rules)
+        if ignore_external_rules:
+            bucket = self._update_keeping_external_rules(
+                params, previous_cors_rules, previous_lifecycle_rules
+            )
+        else:
+            self.api.session.update_bucket(**params)
+            bucket = self.api.get_bucket_by_id(bucket_id)
+        return self._postprocess(
+            bucket,
+            config_cors_rules=cors_rules,
+            config_lifecycle_rules=lifecycle_rules,
+            ignore_external_rules=ignore_external_rules,
+        )
+
+    def _update_keeping_external_rules(self, params, previous_cors_rules, previous_lifecycle_rules):
+        bucket_id = params.pop('bucket_id')
+        params.pop('account_id')
+        cors_rules = params.pop('cors_rules') or []
+        lifecycle_rules = params.pop('lifecycle_rules') or []
+        # rules that were or are managed by this resource, all the other ones are kept as they are
+        managed_cors_rule_names = cors_rule_names(previous_cors_rules) | cors_rule_names(cors_rules)
+        managed_file_name_prefixes = lifecycle_rule_prefixes(
+            previous_lifecycle_rules
+        ) | lifecycle_rule_prefixes(lifecycle_rules)
+
+        def get_params(bucket):
+            return {
+                **params,
+                'cors_rules': [
+                    rule
+                    for rule in bucket.cors_rules
+                    if rule['corsRuleName'] not in managed_cors_rule_names
+                ]
+                + cors_rules,
+                'lifecycle_rules': [
+                    rule
+                    for rule in bucket.lifecycle_rules
+                    if rule['fileNamePrefix'] not in managed_file_name_prefixes
+                ]
+                + lifecycle_rules,
+            }
+
+        return update_bucket_with_revision(self.api, bucket_id, get_params)
 
     def resource_delete(self, *, bucket_id, **kwargs):
         bucket = self.api.get_bucket_by_id(bucket_id)
@@ -307,7 +579,7 @@ class Bucket(Command):
         cors_rules = kwargs.pop('cors_rules', None)
         if cors_rules:
             for index, item in enumerate(cors_rules):
-                cors_rules[index] = change_keys(item, converter=camelize)
+                cors_rules[index] = cors_rule_from_config(item)
 
         for file_lock_configuration in kwargs.pop('file_lock_configuration', ()):
             lock_enabled = file_lock_configuration.get('is_file_lock_enabled')
@@ -350,18 +622,13 @@ class Bucket(Command):
         lifecycle_rules = kwargs.pop('lifecycle_rules', None)
         if lifecycle_rules:
             for index, item in enumerate(lifecycle_rules):
-                days_from_hiding_to_deleting = item.get('days_from_hiding_to_deleting')
-                if days_from_hiding_to_deleting == 0:
-                    item['days_from_hiding_to_deleting'] = None
-                days_from_uploading_to_hiding = item.get('days_from_uploading_to_hiding')
-                if days_from_uploading_to_hiding == 0:
-                    item['days_from_uploading_to_hiding'] = None
-                days_from_starting_to_canceling_unfinished_large_files = item.get(
-                    'days_from_starting_to_canceling_unfinished_large_files'
-                )
-                if days_from_starting_to_canceling_unfinished_large_files == 0:
-                    item['days_from_starting_to_canceling_unfinished_large_files'] = None
-                lifecycle_rules[index] = change_keys(item, converter=camelize)
+                lifecycle_rules[index] = lifecycle_rule_from_config(item)
+
+        # replication is left untouched unless it is configured, it may be managed
+        # by b2_bucket_replication resources instead
+        replication_configuration = kwargs.pop('replication_configuration', None)
+        if replication_configuration:
+            kwargs['replication'] = self._preprocess_replication(replication_configuration[0])
 
         result = {
             'cors_rules': cors_rules,
@@ -371,7 +638,41 @@ class Bucket(Command):
         }
         return result
 
-    def _postprocess(self, obj, config_cors_rules=None, **kwargs):
+    def _preprocess_replication(self, replication_configuration):
+        rules = []
+        source_key_id = None
+        for source in replication_configuration.get('as_replication_source') or ():
+            source_key_id = source['source_application_key_id']
+            for rule in source.get('replication_rules') or ():
+                rules.append(
+                    ReplicationRule(
+                        destination_bucket_id=rule['destination_bucket_id'],
+                        name=rule['replication_rule_name'],
+                        file_name_prefix=rule['file_name_prefix'],
+                        is_enabled=rule['is_enabled'],
+                        priority=rule['priority'],
+                        include_existing_files=rule['include_existing_files'],
+                    )
+                )
+
+        source_to_destination_key_mapping = {}
+        for destination in replication_configuration.get('as_replication_destination') or ():
+            source_to_destination_key_mapping = destination['source_to_destination_key_mapping']
+
+        return ReplicationConfiguration(
+            rules=rules,
+            source_key_id=source_key_id,
+            source_to_destination_key_mapping=source_to_destination_key_mapping,
+        )
+
+    def _postprocess(
+        self,
+        obj,
+        config_cors_rules=None,
+        config_lifecycle_rules=None,
+        ignore_external_rules=False,
+        **kwargs,
+    ):
         kwargs.update(obj.as_dict())
         file_lock_configuration = kwargs['fileLockConfiguration'] = {}
         for key in ('isFileLockEnabled', 'defaultRetention'):
@@ -379,29 +680,473 @@ class Bucket(Command):
             if value is not None and value != {'mode': None}:
                 file_lock_configuration[key] = value
 
-        self._order_allowed_operations(kwargs.get('corsRules', []), config_cors_rules or [])
+        replication = kwargs.pop('replication', None) or {}
+        if replication.get('asReplicationSource') or replication.get('asReplicationDestination'):
+            kwargs['replicationConfiguration'] = replication
+
+        if ignore_external_rules:
+            config_cors_rule_names = cors_rule_names(config_cors_rules)
+            config_file_name_prefixes = lifecycle_rule_prefixes(config_lifecycle_rules)
+            kwargs['corsRules'] = [
+                rule
+                for rule in kwargs.get('corsRules') or ()
+                if rule['corsRuleName'] in config_cors_rule_names
+            ]
+            kwargs['lifecycleRules'] = [
+                rule
+                for rule in kwargs.get('lifecycleRules') or ()
+                if rule['fileNamePrefix'] in config_file_name_prefixes
+            ]
+
+        order_allowed_operations(kwargs.get('corsRules', []), config_cors_rules or [])
         return kwargs
 
-    def _order_allowed_operations(self, cors_rules, config_cors_rules):
-        # B2 does not necessarily return allowed_operations in the same order as they were set.
-        # This can cause unnecessary diffs in the Terraform state.
-        # In order to avoid this, we sort the allowed_operations in the same order as they were set.
-        for cors_rules_item, config_cors_rules_item in zip(cors_rules, config_cors_rules):
-            allowed_operations = cors_rules_item.get('allowedOperations', [])
-            config_allowed_operations = (
-                config_cors_rules_item.get('allowedOperations')
-                or config_cors_rules_item.get('allowed_operations')
-                or []
+
+@B2Provider.register_subcommand
+class BucketCorsRule(Command):
+    def resource_create(self, *, bucket_id, cors_rule_name, **kwargs):
+        def get_params(bucket):
+            if cors_rule_name in cors_rule_names(bucket.cors_rules):
+                raise RuntimeError(
+                    f'CORS rule {cors_rule_name} already exists in bucket {bucket.name}'
+                )
+            rule = cors_rule_from_config(dict(cors_rule_name=cors_rule_name, **kwargs))
+            return {'cors_rules': bucket.cors_rules + [rule]}
+
+        bucket = update_bucket_with_revision(self.api, bucket_id, get_params)
+        return self._postprocess(bucket, cors_rule_name, kwargs)
+
+    def resource_read(self, *, bucket_id, cors_rule_name, **kwargs):
+        try:
+            bucket = self.api.get_bucket_by_id(bucket_id)
+        except BucketIdNotFound:
+            return None  # no bucket has been found
+        return self._postprocess(bucket, cors_rule_name, kwargs)
+
+    def resource_update(self, *, bucket_id, cors_rule_name, **kwargs):
+        def get_params(bucket):
+            rule = cors_rule_from_config(dict(cors_rule_name=cors_rule_name, **kwargs))
+            return {
+                'cors_rules': [
+                    rule if item['corsRuleName'] == cors_rule_name else item
+                    for item in bucket.cors_rules
+                ]
+            }
+
+        bucket = update_bucket_with_revision(self.api, bucket_id, get_params)
+        return self._postprocess(bucket, cors_rule_name, kwargs)
+
+    def resource_delete(self, *, bucket_id, cors_rule_name, **kwargs):
+        def get_params(bucket):
+            return {
+                'cors_rules': [
+                    item for item in bucket.cors_rules if item['corsRuleName'] != cors_rule_name
+                ]
+            }
+
+        try:
+            update_bucket_with_revision(self.api, bucket_id, get_params)
+        except BucketIdNotFound:
+            pass  # bucket was already deleted
+
+    def _postprocess(self, bucket, cors_rule_name, config):
+        for rule in bucket.cors_rules:
+            if rule['corsRuleName'] == cors_rule_name:
+                result = {
+                    'allowedHeaders': [],
+                    'exposeHeaders': [],
+                    **rule,
+                    'bucketId': bucket.id_,
+                    'revision': bucket.revision,
+                }
+                order_allowed_operations([result], [config])
+                return result
+        return None  # no rule has been found
+
+
+@B2Provider.register_subcommand
+class BucketLifecycleRule(Command):
+    def resource_create(self, *, bucket_id, file_name_prefix, **kwargs):
+        def get_params(bucket):
+            if file_name_prefix in lifecycle_rule_prefixes(bucket.lifecycle_rules):
+                raise RuntimeError(
+                    f'lifecycle rule for file name prefix "{file_name_prefix}" already exists in bucket {bucket.name}'
+                )
+            rule = lifecycle_rule_from_config(dict(file_name_prefix=file_name_prefix, **kwargs))
+            return {'lifecycle_rules': bucket.lifecycle_rules + [rule]}
+
+        bucket = update_bucket_with_revision(self.api, bucket_id, get_params)
+        return self._postprocess(bucket, file_name_prefix)
+
+    def resource_read(self, *, bucket_id, file_name_prefix, **kwargs):
+        try:
+            bucket = self.api.get_bucket_by_id(bucket_id)
+        except BucketIdNotFound:
+            return None  # no bucket has been found
+        return self._postprocess(bucket, file_name_prefix)
+
+    def resource_update(self, *, bucket_id, file_name_prefix, **kwargs):
+        def get_params(bucket):
+            rule = lifecycle_rule_from_config(dict(file_name_prefix=file_name_prefix, **kwargs))
+            return {
+                'lifecycle_rules': [
+                    rule if item['fileNamePrefix'] == file_name_prefix else item
+                    for item in bucket.lifecycle_rules
+                ]
+            }
+
+        bucket = update_bucket_with_revision(self.api, bucket_id, get_params)
+        return self._postprocess(bucket, file_name_prefix)
+
+    def resource_delete(self, *, bucket_id, file_name_prefix, **kwargs):
+        def get_params(bucket):
+            return {
+                'lifecycle_rules': [
+                    item
+                    for item in bucket.lifecycle_rules
+                    if item['fileNamePrefix'] != file_name_prefix
+                ]
+            }
+
+        try:
+            update_bucket_with_revision(self.api, bucket_id, get_params)
+        except BucketIdNotFound:
+            pass  # bucket was already deleted
+
+    def _postprocess(self, bucket, file_name_prefix):
+        for rule in bucket.lifecycle_rules:
+            if rule['fileNamePrefix'] == file_name_prefix:
+                return {**rule, 'bucketId': bucket.id_, 'revision': bucket.revision}
+        return None  # no rule has been found
+
+
+@B2Provider.register_subcommand
+class BucketReplication(Command):
+    SOURCE_KEY_CAPABILITIES = ['readFiles', 'readFileLegalHolds', 'readFileRetentions']
+    DESTINATION_KEY_CAPABILITIES = [
+        'writeFiles',
+        'writeFileLegalHolds',
+        'writeFileRetentions',
+        'deleteFiles',
+    ]
+
+    MAX_CREATE_ATTEMPTS = 5
+
+    class SourceKeyChanged(Exception):
+        """Another rule has set a different source key on the source bucket meanwhile."""
+
+    def resource_create(self, **kwargs):
+        for _ in range(self.MAX_CREATE_ATTEMPTS):
+            try:
+                return self._create(**kwargs)
+            except self.SourceKeyChanged:
+                continue  # retry with the source key of the other rule
+        raise RuntimeError(
+            f'Bucket {kwargs["source_bucket_id"]} replication was modified concurrently '
+            f'{self.MAX_CREATE_ATTEMPTS} times, giving up on adding the rule'
+        )
+
+    def _create(
+        self,
+        *,
+        source_bucket_id,
+        destination_bucket_id,
+        replication_rule_name,
+        source_application_key_id,
+        destination_application_key_id,
+        **kwargs,
+    ):
+        source_bucket = self.api.get_bucket_by_id(source_bucket_id)
+        destination_bucket = self.api.get_bucket_by_id(destination_bucket_id)
+        source_replication = source_bucket.replication or ReplicationConfiguration()
+        destination_replication = destination_bucket.replication or ReplicationConfiguration()
+
+        # the keys created by the provider are named after their bucket, so that the rules
+        # sharing them can tell them apart from user keys, and the last rule deletes them
+        source_key_name = f'{source_bucket.name}-replication-source'
+        destination_key_name = f'{destination_bucket.name}-replication-destination'
+        user_key_ids = {source_application_key_id, destination_application_key_id} - {''}
+        created_key_ids = []
+        if not source_application_key_id:
+            # all rules of a bucket share the same source key
+            source_application_key_id = source_replication.source_key_id
+        elif source_replication.rules and source_replication.source_key_id not in (
+            None,
+            source_application_key_id,
+        ):
+            self._raise_source_key_conflict(source_bucket_id, source_replication.source_key_id)
+        mapping_added = mapping_existed = False
+        try:
+            if not source_application_key_id:
+                source_application_key_id = self._create_key(
+                    self.SOURCE_KEY_CAPABILITIES, source_key_name, source_bucket_id
+                )
+                created_key_ids.append(source_application_key_id)
+            if not destination_application_key_id:
+                # all rules of a source key replicating to the same bucket share the same
+                # destination key
+                destination_application_key_id = (
+                    destination_replication.source_to_destination_key_mapping.get(
+                        source_application_key_id
+                    )
+                )
+            if not destination_application_key_id:
+                destination_application_key_id = self._create_key(
+                    self.DESTINATION_KEY_CAPABILITIES, destination_key_name, destination_bucket_id
+                )
+                created_key_ids.append(destination_application_key_id)
+
+            def get_destination_params(bucket):
+                nonlocal destination_application_key_id, mapping_existed
+                replication = bucket.replication or ReplicationConfiguration()
+                mapping = dict(replication.source_to_destination_key_mapping)
+                mapped_key_id = mapping.get(source_application_key_id)
+                mapping_existed = mapped_key_id is not None
+                if mapped_key_id and destination_application_key_id not in user_key_ids:
+                    # another rule has mapped the source key meanwhile
+                    destination_application_key_id = mapped_key_id
+                mapping[source_application_key_id] = destination_application_key_id
+                return {
+                    'replication': ReplicationConfiguration(
+                        rules=replication.rules,
+                        source_key_id=replication.source_key_id,
+                        source_to_destination_key_mapping=mapping,
+                    )
+                }
+
+            update_bucket_with_revision(self.api, destination_bucket_id, get_destination_params)
+            mapping_added = True
+
+            def get_source_params(bucket):
+                replication = bucket.replication or ReplicationConfiguration()
+                other_rules = [
+                    rule for rule in replication.rules if rule.name != replication_rule_name
+                ]
+                if other_rules and replication.source_key_id not in (
+                    None,
+                    source_application_key_id,
+                ):
+                    if source_application_key_id in user_key_ids:
+                        self._raise_source_key_conflict(source_bucket_id, replication.source_key_id)
+                    raise self.SourceKeyChanged()
+                return self._replication_params(
+                    replication,
+                    source_application_key_id=source_application_key_id,
+                    destination_bucket_id=destination_bucket_id,
+                    replication_rule_name=replication_rule_name,
+                    **kwargs,
+                )
+
+            update_bucket_with_revision(self.api, source_bucket_id, get_source_params)
+        except BaseException:
+            # neither the created keys nor the added mapping are used by any rule
+            if mapping_added and not mapping_existed:
+                self._remove_key_mapping(destination_bucket_id, source_application_key_id)
+            self._delete_keys(created_key_ids)
+            raise
+
+        # a destination key created here is unused when another rule has mapped the source key
+        self._delete_keys(
+            [
+                key_id
+                for key_id in created_key_ids
+                if key_id not in (source_application_key_id, destination_application_key_id)
+            ]
+        )
+        managed_application_key_ids = [
+            key_id
+            for key_id, key_name in (
+                (source_application_key_id, source_key_name),
+                (destination_application_key_id, destination_key_name),
+            )
+            if key_id not in user_key_ids
+            and (key_id in created_key_ids or self._is_key_named(key_id, key_name))
+        ]
+        result = self.resource_read(
+            source_bucket_id=source_bucket_id,
+            replication_rule_name=replication_rule_name,
+        )
+        result['managedApplicationKeyIds'] = managed_application_key_ids
+        return result
+
+    def resource_read(self, *, source_bucket_id, replication_rule_name, **kwargs):
+        try:
+            source_bucket = self.api.get_bucket_by_id(source_bucket_id)
+        except BucketIdNotFound:
+            return None  # no bucket has been found
+
+        source_replication = source_bucket.replication or ReplicationConfiguration()
+        for rule in source_replication.rules:
+            if rule.name == replication_rule_name:
+                break
+        else:
+            return None  # no replication rule has been found
+
+        try:
+            destination_bucket = self.api.get_bucket_by_id(rule.destination_bucket_id)
+            destination_replication = destination_bucket.replication or ReplicationConfiguration()
+            destination_application_key_id = (
+                destination_replication.source_to_destination_key_mapping.get(
+                    source_replication.source_key_id
+                )
             )
-            if allowed_operations:
+        except BucketIdNotFound:
+            destination_application_key_id = None
+
+        return self._postprocess(
+            rule,
+            sourceBucketId=source_bucket_id,
+            sourceApplicationKeyId=source_replication.source_key_id,
+            destinationApplicationKeyId=destination_application_key_id,
+        )
+
+    def resource_update(self, *, source_bucket_id, replication_rule_name, **kwargs):
+        update_bucket_with_revision(
+            self.api,
+            source_bucket_id,
+            lambda bucket: self._replication_params(
+                bucket.replication or ReplicationConfiguration(),
+                replication_rule_name=replication_rule_name,
+                **kwargs,
+            ),
+        )
+        return self.resource_read(
+            source_bucket_id=source_bucket_id,
+            replication_rule_name=replication_rule_name,
+        )
+
+    def resource_delete(
+        self,
+        *,
+        source_bucket_id,
+        destination_bucket_id,
+        replication_rule_name,
+        source_application_key_id,
+        destination_application_key_id,
+        managed_application_key_ids,
+        **kwargs,
+    ):
+        remaining_rules = []
+
+        def get_source_params(bucket):
+            nonlocal remaining_rules
+            replication = bucket.replication or ReplicationConfiguration()
+            remaining_rules = [
+                rule for rule in replication.rules if rule.name != replication_rule_name
+            ]
+            return {
+                'replication': ReplicationConfiguration(
+                    rules=remaining_rules,
+                    source_key_id=replication.source_key_id if remaining_rules else None,
+                    source_to_destination_key_mapping=(
+                        replication.source_to_destination_key_mapping
+                    ),
+                )
+            }
 
-                def sort_key(allowed_operation):
-                    try:
-                        return config_allowed_operations.index(allowed_operation)
-                    except ValueError:
-                        return -1
+        try:
+            update_bucket_with_revision(self.api, source_bucket_id, get_source_params)
+        except BucketIdNotFound:
+            pass  # source bucket already removed
+
+        # the key mapping is shared by all rules replicating to the same destination bucket
+        mapping_in_use = any(
+            rule.destination_bucket_id == destination_bucket_id for rule in remaining_rules
+        )
+        if not mapping_in_use:
+            self._remove_key_mapping(destination_bucket_id, source_application_key_id)
+
+        for key_id in managed_application_key_ids:
+            if key_id == source_application_key_id and remaining_rules:
+                continue  # still used by other rules of the source bucket
+            if key_id == destination_application_key_id and mapping_in_use:
+                continue  # still used by other rules replicating to the destination bucket
+            try:
+                self.api.delete_key_by_id(application_key_id=key_id)
+            except BadRequest:
+                pass  # key was already deleted
+
+    def _create_key(self, capabilities, key_name, bucket_id):
+        key = self.api.create_key(
+            capabilities=capabilities,
+            key_name=key_name,
+            bucket_ids=[bucket_id],
+        )
+        return key.id_
+
+    def _delete_keys(self, key_ids):
+        for key_id in key_ids:
+            try:
+                self.api.delete_key_by_id(application_key_id=key_id)
+            except B2Error:
+                pass  # the key is not used anyway
+
+    def _remove_key_mapping(self, destination_bucket_id, source_application_key_id):
+        def get_params(bucket):
+            replication = bucket.replication or ReplicationConfiguration()
+            mapping = dict(replication.source_to_destination_key_mapping)
+            mapping.pop(source_application_key_id, None)
+            return {
+                'replication': ReplicationConfiguration(
+                    rules=replication.rules,
+                    source_key_id=replication.source_key_id,
+                    source_to_destination_key_mapping=mapping,
+                )
+            }
+
+        try:
+            update_bucket_with_revision(self.api, destination_bucket_id, get_params)
+        except BucketIdNotFound:
+            pass  # destination bucket already removed
+
+    def _raise_source_key_conflict(self, source_bucket_id, source_key_id):
+        raise RuntimeError(
+            f'Bucket {source_bucket_id} already replicates with source key '
+            f'"{source_key_id}", all its rules have to use the same key'
+        )
+
+    def _is_key_named(self, application_key_id, key_name):
+        for key in self.api.list_keys(application_key_id):
+            return key.id_ == application_key_id and key.key_name == key_name
+        return False
+
+    def _replication_params(
+        self,
+        source_replication,
+        *,
+        destination_bucket_id,
+        replication_rule_name,
+        file_name_prefix,
+        priority,
+        include_existing_files,
+        is_enabled,
+        source_application_key_id=None,
+        **kwargs,
+    ):
+        rules = [rule for rule in source_replication.rules if rule.name != replication_rule_name]
+        rules.append(
+            ReplicationRule(
+                destination_bucket_id=destination_bucket_id,
+                name=replication_rule_name,
+                file_name_prefix=file_name_prefix,
+                is_enabled=is_enabled,
+                priority=priority,
+                include_existing_files=include_existing_files,
+            )
+        )
+        return {
+            'replication': ReplicationConfiguration(
+                rules=rules,
+                source_key_id=source_application_key_id or source_replication.source_key_id,
+                source_to_destination_key_mapping=(
+                    source_replication.source_to_destination_key_mapping
+                ),
+            )
+        }
 
-                allowed_operations.sort(key=sort_key)
+    def _postprocess(self, obj=None, **kwargs):
+        kwargs.update(obj.as_dict())
+        return kwargs
 
 
 @B2Provider.register_subcommand
@@ -415,6 +1160,8 @@ class BucketFileVersion(Command):
         content_type,
         file_info,
         server_side_encryption,
+        file_retention,
+        legal_hold,
         **kwargs,
     ):
         bucket = self.api.get_bucket_by_id(bucket_id)
@@ -425,6 +1172,8 @@ class BucketFileVersion(Command):
                 content_type=content_type,
                 file_info=file_info,
                 server_side_encryption=server_side_encryption,
+                file_retention=file_retention,
+                legal_hold=legal_hold,
             ),
         )
         return self._postprocess(file_info, source=source, bucket_id=bucket_id)
@@ -432,8 +1181,27 @@ class BucketFileVersion(Command):
     def resource_read(self, *, file_id, **kwargs):
         return self._postprocess(self.api.get_file_info(file_id))
 
-    def resource_delete(self, *, file_id, file_name, **kwargs):
-        self.api.delete_file_version(file_id, file_name)
+    def resource_update(
+        self, *, file_id, file_name, file_retention, legal_hold, bypass_governance, **kwargs
+    ):
+        # only the changed settings are passed here
+        file_retention = file_retention_from_config(file_retention)
+        if file_retention is not None:
+            self.api.update_file_retention(
+                file_id, file_name, file_retention, bypass_governance=bypass_governance
+            )
+        if legal_hold:
+            self.api.update_file_legal_hold(file_id, file_name, LegalHold(legal_hold))
+        return self._postprocess(self.api.get_file_info(file_id))
+
+    def resource_delete(
+        self, *, file_id, bucket_id, file_name, destroy_mode, bypass_governance, **kwargs
+    ):
+        if destroy_mode == 'hide':
+            bucket = self.api.get_bucket_by_id(bucket_id)
+            bucket.hide_file(file_name)
+            return
+        self.api.delete_file_version(file_id, file_name, bypass_governance=bypass_governance)
 
     def _preprocess(self, **kwargs):
         content_type = kwargs.pop('content_type') or None
@@ -469,52 +1237,232 @@ class BucketFileVersion(Command):
         else:
             server_side_encryption = None
 
+        file_retention = file_retention_from_config(kwargs.pop('file_retention'))
+        legal_hold = apply_or_none(LegalHold, kwargs.pop('legal_hold') or None)
+
         return {
             'content_type': content_type,
             'encryption': server_side_encryption,
+            'file_retention': file_retention,
+            'legal_hold': legal_hold,
+            **kwargs,
+        }
+
+    def _postprocess(self, obj=None, **kwargs):
+        kwargs.update(file_version_as_dict(obj))
+        return self._convert_objects_to_dicts(kwargs)
+
+
+@B2Provider.register_subcommand
+class BucketFileHide(Command):
+    def resource_create(self, *, bucket_id, file_name, **kwargs):
+        bucket = self.api.get_bucket_by_id(bucket_id)
+        file_version = bucket.hide_file(file_name)
+        return self._postprocess(file_version, bucketId=bucket_id)
+
+    def resource_read(self, *, file_id, **kwargs):
+        try:
+            file_version = self.api.get_file_info(file_id)
+        except FileNotPresent:
+            return None  # no hide marker has been found
+        if file_version.action != 'hide':
+            return None  # the imported file ID is not a hide marker
+        return self._postprocess(file_version, bucketId=file_version.bucket_id)
+
+    def resource_delete(self, *, file_id, file_name, **kwargs):
+        # deleting the hide marker makes the previous file version visible again
+        try:
+            self.api.delete_file_version(file_id, file_name)
+        except FileNotPresent:
+            pass  # hide marker was already deleted
+
+
+@B2Provider.register_subcommand
+class BucketFileRetention(Command):
+    def resource_create(
+        self, *, file_id, mode, retain_until_timestamp, bypass_governance, **kwargs
+    ):
+        file_version = self.api.get_file_info(file_id)
+        self.api.update_file_retention(
+            file_id,
+            file_version.file_name,
+            FileRetentionSetting(RetentionMode(mode), retain_until_timestamp),
+            bypass_governance=bypass_governance,
+        )
+        return self._postprocess(self.api.get_file_info(file_id))
+
+    def resource_read(self, *, file_id, **kwargs):
+        try:
+            file_version = self.api.get_file_info(file_id)
+        except FileNotPresent:
+            return None  # no file version has been found
+        return self._postprocess(file_version)
+
+    def resource_update(self, **kwargs):
+        return self.resource_create(**kwargs)
+
+    def resource_delete(self, *, file_id, bypass_governance, **kwargs):
+        try:
+            file_version = self.api.get_file_info(file_id)
+        except FileNotPresent:
+            return  # file version already removed
+        self.api.update_file_retention(
+            file_id,
+            file_version.file_name,
+            FileRetentionSetting(RetentionMode.NONE),
+            bypass_governance=bypass_governance,
+        )
+
+    def _postprocess(self, obj=None, **kwargs):
+        file_retention = obj.file_retention
+        return {
+            'fileId': obj.id_,
+            'fileName': obj.file_name,
+            'mode': file_retention.mode.value,
+            'retainUntilTimestamp': file_retention.retain_until,
+            **kwargs,
+        }
+
+
+@B2Provider.register_subcommand
+class BucketFileLegalHold(Command):
+    def resource_create(self, *, file_id, legal_hold, **kwargs):
+        file_version = self.api.get_file_info(file_id)
+        self.api.update_file_legal_hold(file_id, file_version.file_name, LegalHold(legal_hold))
+        return self._postprocess(self.api.get_file_info(file_id))
+
+    def resource_read(self, *, file_id, **kwargs):
+        try:
+            file_version = self.api.get_file_info(file_id)
+        except FileNotPresent:
+            return None  # no file version has been found
+        return self._postprocess(file_version)
+
+    def resource_update(self, **kwargs):
+        return self.resource_create(**kwargs)
+
+    def resource_delete(self, *, file_id, **kwargs):
+        try:
+            file_version = self.api.get_file_info(file_id)
+        except FileNotPresent:
+            return  # file version already removed
+        self.api.update_file_legal_hold(file_id, file_version.file_name, LegalHold.OFF)
+
+    def _postprocess(self, obj=None, **kwargs):
+        return {
+            'fileId': obj.id_,
+            'fileName': obj.file_name,
+            'legalHold': obj.legal_hold.value,
             **kwargs,
         }
 
 
 @B2Provider.register_subcommand
 class BucketNotificationRules(Command):
+    # B2 does not check a revision when the notification rules are set, so in non-exclusive mode
+    # the rules are read again after a while and merged again when a concurrent update of
+    # another resource has dropped them
+    MERGE_SETTLE_SECONDS = 2
+    MAX_MERGE_ATTEMPTS = 5
+
     def data_source_read(self, *, bucket_id, **kwargs):
         bucket = self.api.get_bucket_by_id(bucket_id)
         rules = bucket.get_notification_rules()
         return self._postprocess(bucketId=bucket_id, notificationRules=rules)
 
-    def resource_create(self, *, bucket_id, notification_rules, **kwargs):
+    def resource_create(self, *, bucket_id, notification_rules, exclusive, **kwargs):
         params = self._preprocess(notification_rules=notification_rules)
         bucket = self.api.get_bucket_by_id(bucket_id)
-        rules = bucket.set_notification_rules(**params)
-        return self._postprocess(bucketId=bucket_id, notificationRules=rules)
+        if exclusive:
+            rules = bucket.set_notification_rules(**params)
+        else:
+            rules = self._set_merged_rules(bucket, params['rules'], ())
+        return self._postprocess(
+            bucketId=bucket_id,
+            notificationRules=self._owned_rules(rules, notification_rules, exclusive),
+        )
 
-    def resource_read(self, *, bucket_id, **kwargs):
+    def resource_read(self, *, bucket_id, notification_rules, exclusive, **kwargs):
         try:
             bucket = self.api.get_bucket_by_id(bucket_id)
         except BucketIdNotFound:
             return None  # no bucket has been found
 
         rules = bucket.get_notification_rules()
-        return self._postprocess(bucketId=bucket_id, notificationRules=rules)
+        return self._postprocess(
+            bucketId=bucket_id,
+            notificationRules=self._owned_rules(rules, notification_rules, exclusive),
+        )
 
-    def resource_update(self, *, bucket_id, notification_rules, **kwargs):
+    def resource_update(
+        self, *, bucket_id, notification_rules, exclusive, previous_notification_rules, **kwargs
+    ):
         params = self._preprocess(notification_rules=notification_rules)
         bucket = self.api.get_bucket_by_id(bucket_id)
-        rules = bucket.set_notification_rules(**params)
-        return self._postprocess(bucketId=bucket_id, notificationRules=rules)
+        if exclusive:
+            rules = bucket.set_notification_rules(**params)
+        else:
+            rules = self._set_merged_rules(bucket, params['rules'], previous_notification_rules)
+        return self._postprocess(
+            bucketId=bucket_id,
+            notificationRules=self._owned_rules(rules, notification_rules, exclusive),
+        )
 
-    def resource_delete(self, *, bucket_id, **kwargs):
+    def resource_delete(self, *, bucket_id, notification_rules, exclusive, **kwargs):
         try:
             bucket = self.api.get_bucket_by_id(bucket_id)
         except BucketIdNotFound:
             return  # bucket that contains notification rules already removed
 
-        bucket.set_notification_rules([])
+        if exclusive:
+            bucket.set_notification_rules([])
+        else:
+            self._set_merged_rules(bucket, [], notification_rules)
+
+    def _set_merged_rules(self, bucket, rules, previous_rules):
+        names = {rule['name'] for rule in rules}
+        removed_names = {rule['name'] for rule in previous_rules} - names
+        for _ in range(self.MAX_MERGE_ATTEMPTS):
+            bucket.set_notification_rules(self._merge_rules(bucket, rules, previous_rules))
+            time.sleep(self.MERGE_SETTLE_SECONDS)
+            current_rules = bucket.get_notification_rules()
+            current_names = {rule['name'] for rule in current_rules}
+            if names <= current_names and not removed_names & current_names:
+                return current_rules
+        raise RuntimeError(
+            f'notification rules of bucket {bucket.id_} were modified concurrently '
+            f'{self.MAX_MERGE_ATTEMPTS} times, giving up on updating them'
+        )
+
+    def _merge_rules(self, bucket, rules, previous_rules):
+        # keep the rules managed elsewhere, replacing the ones that were or are managed by the resource
+        managed_names = {rule['name'] for rule in previous_rules} | {rule['name'] for rule in rules}
+        other_rules = [
+            {
+                key: value
+                for key, value in rule.items()
+                if key not in ('isSuspended', 'suspensionReason')
+            }
+            for rule in bucket.get_notification_rules()
+            if rule['name'] not in managed_names
+        ]
+        return other_rules + rules
+
+    def _owned_rules(self, rules, config_rules, exclusive):
+        if exclusive:
+            return rules
+        names = [rule['name'] for rule in config_rules or ()]
+        return sorted(
+            (rule for rule in rules if rule['name'] in names),
+            key=lambda rule: names.index(rule['name']),
+        )
 
     def _preprocess(self, **kwargs):
         notification_rules = []
         for notification_rule in kwargs.pop('notification_rules'):
+            # the signing secret is generated by the provider, B2 does not know these settings
+            notification_rule['target_configuration'][0].pop('generate_signing_secret', None)
+            notification_rule['target_configuration'][0].pop('signing_secret_version', None)
             if not notification_rule['target_configuration'][0]['hmac_sha256_signing_secret']:
                 del notification_rule['target_configuration'][0]['hmac_sha256_signing_secret']
             notification_rule['target_configuration'] = change_keys(
@@ -531,25 +1479,71 @@ class BucketNotificationRules(Command):
 
 @B2Provider.register_subcommand
 class BucketFile(Command):
-    def data_source_read(self, *, bucket_id, file_name, show_versions, **kwargs):
-        bucket = self.api.get_bucket_by_id(bucket_id)
-        file_versions = bucket.list_file_versions(file_name)
-        if show_versions:
+    def data_source_read(
+        self,
+        *,
+        bucket_id,
+        file_name,
+        show_versions,
+        file_id,
+        at_timestamp,
+        include_hidden,
+        fail_if_not_found,
+        **kwargs,
+    ):
+        try:
+            bucket = self.api.get_bucket_by_id(bucket_id)
+        except BucketIdNotFound:
+            if fail_if_not_found:
+                raise
+            file_versions = iter([])  # no bucket has been found
+        else:
+            file_versions = bucket.list_file_versions(file_name)
+        if show_versions or file_id or at_timestamp or include_hidden:
             file_versions = list(file_versions)
         else:
-            try:
-                latest = next(file_versions)
-                file_versions = [latest]
-            except StopIteration:
-                file_versions = []
+            # the unfinished large files are listed too, and are not versions of the file
+            latest = next(
+                (file_version for file_version in file_versions if file_version.action != 'start'),
+                None,
+            )
+            file_versions = [latest] if latest else []
+
+        selected_version = self._select_version(
+            file_versions, file_id, at_timestamp, include_hidden
+        )
+        if not show_versions:
+            file_versions = [
+                file_version for file_version in file_versions if file_version.action != 'start'
+            ][:1]
 
         return self._postprocess(
             bucketId=bucket_id,
             fileName=file_name,
             showVersions=show_versions,
-            fileVersions=file_versions,
+            fileVersions=[file_version_as_dict(file_version) for file_version in file_versions],
+            selectedVersion=apply_or_none(file_version_as_dict, selected_version),
         )
 
+    @classmethod
+    def _select_version(cls, file_versions, file_id, at_timestamp, include_hidden):
+        # the file versions are listed from the latest one
+        for file_version in file_versions:
+            if file_version.action == 'start':
+                # unfinished large file
+                continue
+            if file_id:
+                if file_version.id_ == file_id:
+                    return file_version
+            elif at_timestamp and file_version.upload_timestamp > at_timestamp:
+                continue
+            elif file_version.action == 'upload':
+                return file_version
+            elif file_version.action == 'hide' and not include_hidden:
+                # the provider reports the hidden file as absent
+                return file_version
+        return None
+
 
 @B2Provider.register_subcommand
 class BucketFileSignedUrl(Command):
@@ -568,21 +1562,371 @@ class BucketFileSignedUrl(Command):
         )
 
 
+@B2Provider.register_subcommand
+class BucketFileContent(Command):
+    def data_source_read(
+        self,
+        *,
+        bucket_id,
+        file_name,
+        file_id,
+        sse_c_key_b64,
+        byte_range,
+        max_size,
+        output_path,
+        **kwargs,
+    ):
+        encryption = None
+        if sse_c_key_b64:
+            # EncryptionKey only accepts raw bytes as keys, not base 64
+            encryption = EncryptionSetting(
+                mode=EncryptionMode.SSE_C,
+                algorithm=EncryptionAlgorithm.AES256,
+                key=EncryptionKey(secret=base64.b64decode(sse_c_key_b64, validate=True)),
+            )
+        range_ = tuple(byte_range) or None
+
+        if file_id:
+            downloaded_file = self.api.download_file_by_id(
+                file_id, range_=range_, encryption=encryption
+            )
+        else:
+            bucket = self.api.get_bucket_by_id(bucket_id)
+            downloaded_file = bucket.download_file_by_name(
+                file_name, range_=range_, encryption=encryption
+            )
+        download_version = downloaded_file.download_version
+        if download_version.file_name != file_name:
+            raise RuntimeError(
+                f'File "{file_id}" is named "{download_version.file_name}", not "{file_name}"'
+            )
+
+        content_length = download_version.content_length
+        if not output_path and content_length > max_size:
+            raise RuntimeError(
+                f'File "{file_name}" is too large ({content_length} bytes), '
+                f'the maximum size is {max_size} bytes, use output_path to download it'
+            )
+
+        sha1 = hashlib.sha1()
+        if output_path:
+            downloaded_file.save_to(output_path)
+            with open(output_path, 'rb') as f:
+                for chunk in iter(lambda: f.read(1024 * 1024), b''):
+                    sha1.update(chunk)
+            content = b''
+        else:
+            buffer = io.BytesIO()
+            downloaded_file.save(buffer)
+            content = buffer.getvalue()
+            sha1.update(content)
+
+        if range_ is None:
+            self._verify_sha1(download_version, sha1.hexdigest())
+
+        return self._postprocess(
+            bucketId=bucket_id,
+            fileName=file_name,
+            fileId=download_version.id_,
+            contentBase64=base64.b64encode(content).decode(),
+            contentLength=content_length,
+            contentSha1=download_version.content_sha1,
+            contentType=download_version.content_type,
+            fileInfo=download_version.file_info,
+            size=download_version.size,
+            uploadTimestamp=download_version.upload_timestamp,
+        )
+
+    @classmethod
+    def _verify_sha1(cls, download_version, actual_sha1):
+        expected_sha1 = download_version.content_sha1
+        if expected_sha1 == 'none':
+            # large files have their checksum in the file info, if at all
+            expected_sha1 = download_version.file_info.get('large_file_sha1')
+        if expected_sha1 and expected_sha1 != actual_sha1:
+            raise RuntimeError(
+                f'SHA1 checksum mismatch for file "{download_version.file_name}": '
+                f'expected {expected_sha1}, got {actual_sha1}'
+            )
+
+
 @B2Provider.register_subcommand
 class BucketFiles(Command):
-    def data_source_read(self, *, bucket_id, folder_name, show_versions, recursive, **kwargs):
-        bucket = self.api.get_bucket_by_id(bucket_id)
-        generator = bucket.ls(
+    # the number of file names or versions listed per request
+    FETCH_COUNT = 1000
+
+    def data_source_read(
+        self,
+        *,
+        bucket_id,
+        folder_name,
+        show_versions,
+        recursive,
+        start_file_name,
+        start_file_id,
+        max_results,
+        **kwargs,
+    ):
+        matches = self._filter(**kwargs)
+        # The entries are listed in file name order, the provider needs one more entry than
+        # max_results to find the name of the next page, and sorts the returned entries
+        entries = []
+        for file_version_info, subfolder_name in self._ls(
+            bucket_id,
             folder_name,
             latest_only=not show_versions,
             recursive=recursive,
-        )
+            start_file_name=start_file_name,
+            start_file_id=start_file_id,
+        ):
+            if not matches(file_version_info, subfolder_name):
+                continue
+            entries.append(
+                {**file_version_as_dict(file_version_info), 'folderName': subfolder_name}
+            )
+            if max_results and len(entries) > max_results:
+                break
         return self._postprocess(
             bucketId=bucket_id,
             folderName=folder_name,
             showVersions=show_versions,
             recursive=recursive,
-            fileVersions=[file_version_info for file_version_info, _ in generator],
+            entries=entries,
+        )
+
+    def _ls(
+        self, bucket_id, folder_name, *, latest_only, recursive, start_file_name, start_file_id
+    ):
+        # Like Bucket.ls, but the raw API is used to start the listing at the given file
+        # name and version instead of at the beginning of the folder
+        prefix = folder_name
+        if prefix and not prefix.endswith('/'):
+            prefix += '/'
+        start_file_name = max(start_file_name, prefix)
+        start_file_id = start_file_id or None
+        current_dir = None
+        while True:
+            if latest_only:
+                response = self.api.session.list_file_names(
+                    bucket_id, start_file_name, self.FETCH_COUNT, prefix
+                )
+            else:
+                response = self.api.session.list_file_versions(
+                    bucket_id, start_file_name, start_file_id, self.FETCH_COUNT, prefix
+                )
+            for entry in response['files']:
+                file_version = self.api.file_version_factory.from_api_response(entry)
+                if not file_version.file_name.startswith(prefix):
+                    return
+                after_prefix = file_version.file_name[len(prefix) :]
+                if '/' not in after_prefix or recursive:
+                    yield file_version, None
+                    current_dir = None
+                    continue
+                # the files of a subfolder are listed as the subfolder, once
+                folder_with_slash = after_prefix.split('/')[0] + '/'
+                if folder_with_slash != current_dir:
+                    yield file_version, prefix + folder_with_slash
+                    current_dir = folder_with_slash
+            if response['nextFileName'] is None:
+                return
+            if current_dir is None:
+                start_file_name = response['nextFileName']
+                start_file_id = response.get('nextFileId')
+            else:
+                # skip the rest of the subfolder, the character after '/' is '0'
+                start_file_name = max(response['nextFileName'], prefix + current_dir[:-1] + '0')
+                start_file_id = None
+
+    @classmethod
+    def _filter(
+        cls,
+        *,
+        include_glob,
+        exclude_glob,
+        include_regex,
+        exclude_regex,
+        min_size,
+        max_size,
+        filter_max_size,
+        uploaded_after,
+        uploaded_before,
+        actions,
+        **kwargs,
+    ):
+        include_glob = include_glob and glob_to_regex(include_glob)
+        exclude_glob = exclude_glob and glob_to_regex(exclude_glob)
+        include_regex = include_regex and re.compile(include_regex)
+        exclude_regex = exclude_regex and re.compile(exclude_regex)
+        uploaded_after = uploaded_after and timestamp_millis(uploaded_after)
+        uploaded_before = uploaded_before and timestamp_millis(uploaded_before)
+
+        def matches(file_version_info, subfolder_name):
+            name = subfolder_name or file_version_info.file_name
+            if include_glob and not include_glob.fullmatch(name):
+                return False
+            if exclude_glob and exclude_glob.fullmatch(name):
+                return False
+            if include_regex and not include_regex.search(name):
+                return False
+            if exclude_regex and exclude_regex.search(name):
+                return False
+            action = 'folder' if subfolder_name else file_version_info.action
+            if actions and action not in actions:
+                return False
+            # Folders are not filtered by size and upload time
+            if subfolder_name:
+                return True
+            size = file_version_info.size
+            if size < min_size or (filter_max_size and size > max_size):
+                return False
+            upload_timestamp = file_version_info.upload_timestamp
+            if uploaded_after and upload_timestamp <= uploaded_after:
+                return False
+            if uploaded_before and upload_timestamp >= uploaded_before:
+                return False
+            return True
+
+        return matches
+
+
+@B2Provider.register_subcommand
+class BucketUsage(Command):
+    def data_source_read(self, *, bucket_id, folder_name, group_by_folder, **kwargs):
+        bucket = self.api.get_bucket_by_id(bucket_id)
+        # ls lists folders, so the folder name is used as a prefix with a trailing slash
+        prefix = folder_name if not folder_name or folder_name.endswith('/') else folder_name + '/'
+
+        # The versions are streamed, and only the sums are kept in memory
+        usage = self._new_usage()
+        folders = {}
+        file_name = None
+        latest_action = None
+        for file_version, _ in bucket.ls(folder_name, latest_only=False, recursive=True):
+            if file_version.action not in ('upload', 'hide'):
+                continue
+            usages = self._usages(usage, folders, group_by_folder, prefix, file_version.file_name)
+            # the versions of a file are listed from the latest one
+            if file_version.file_name != file_name:
+                file_name = file_version.file_name
+                latest_action = file_version.action
+                if latest_action == 'upload':
+                    self._add(usages, 'fileCount', 1)
+            self._add(usages, 'versionCount', 1)
+            if file_version.action == 'upload':
+                self._add(usages, 'totalBytes', file_version.size)
+                if latest_action == 'hide':
+                    self._add(usages, 'hiddenBytes', file_version.size)
+
+        for unfinished_file in bucket.list_unfinished_large_files(prefix=prefix):
+            usages = self._usages(usage, folders, group_by_folder, prefix, unfinished_file.file_name)
+            self._add(usages, 'unfinishedLargeFileCount', 1)
+            for part in bucket.list_parts(unfinished_file.file_id):
+                self._add(usages, 'unfinishedLargeFileBytes', part.content_length)
+
+        return self._postprocess(
+            bucketId=bucket_id,
+            folderName=folder_name,
+            groupByFolder=group_by_folder,
+            folders=[folders[name] for name in sorted(folders)],
+            **usage,
+        )
+
+    @classmethod
+    def _new_usage(cls, **kwargs):
+        return {
+            **kwargs,
+            'totalBytes': 0,
+            'fileCount': 0,
+            'versionCount': 0,
+            'hiddenBytes': 0,
+            'unfinishedLargeFileBytes': 0,
+            'unfinishedLargeFileCount': 0,
+        }
+
+    @classmethod
+    def _usages(cls, usage, folders, group_by_folder, prefix, file_name):
+        if not group_by_folder:
+            return [usage]
+        relative_name = file_name[len(prefix) :]
+        folder_name = relative_name.split('/')[0] + '/' if '/' in relative_name else ''
+        if folder_name not in folders:
+            folders[folder_name] = cls._new_usage(folderName=folder_name)
+        return [usage, folders[folder_name]]
+
+    @classmethod
+    def _add(cls, usages, key, value):
+        for usage in usages:
+            usage[key] += value or 0
+
+
+@B2Provider.register_subcommand
+class BucketUnfinishedLargeFiles(Command):
+    def data_source_read(self, *, bucket_id, name_prefix, started_before, **kwargs):
+        return self._postprocess(
+            files=self._list_files(bucket_id, name_prefix, started_before),
+        )
+
+    def _list_files(self, bucket_id, name_prefix, started_before):
+        # the raw API is used, as the SDK objects do not have the upload timestamp
+        bucket = self.api.get_bucket_by_id(bucket_id)
+        files = []
+        start_file_id = None
+        while True:
+            response = self.api.session.list_unfinished_large_files(
+                bucket_id,
+                start_file_id=start_file_id,
+                max_file_count=100,
+                prefix=name_prefix or None,
+            )
+            for file_dict in response['files']:
+                if started_before and file_dict['uploadTimestamp'] >= started_before:
+                    continue
+                parts = list(bucket.list_parts(file_dict['fileId']))
+                files.append(
+                    {
+                        'fileId': file_dict['fileId'],
+                        'fileName': file_dict['fileName'],
+                        'contentType': file_dict['contentType'],
+                        'fileInfo': file_dict['fileInfo'],
+                        'uploadTimestamp': file_dict['uploadTimestamp'],
+                        'partCount': len(parts),
+                        'uploadedBytes': sum(part.content_length for part in parts),
+                    }
+                )
+            start_file_id = response.get('nextFileId')
+            if start_file_id is None:
+                break
+        return sorted(files, key=lambda file: (file['fileName'], file['uploadTimestamp']))
+
+
+@B2Provider.register_subcommand
+class BucketUnfinishedLargeFilesCleanup(Command):
+    def resource_create(self, *, bucket_id, name_prefix, started_before, **kwargs):
+        # the files are listed first, so that the canceled ones can be reported
+        files_command = BucketUnfinishedLargeFiles(self.provider_tool)
+        files = files_command._list_files(bucket_id, name_prefix, started_before)
+        canceled_files = []
+        for file in files:
+            try:
+                self.api.cancel_large_file(file['fileId'])
+            except FileNotPresent:
+                # the file has been finished or canceled since it was listed
+                continue
+            canceled_files.append(file)
+        return self._postprocess(
+            canceledFiles=canceled_files,
+        )
+
+
+@B2Provider.register_subcommand
+class Buckets(Command):
+    def data_source_read(self, **kwargs):
+        # The buckets are filtered by the provider
+        bucket_command = Bucket(self.provider_tool)
+        buckets = sorted(self.api.list_buckets(), key=lambda bucket: bucket.name)
+        return self._postprocess(
+            buckets=[bucket_command._postprocess(bucket) for bucket in buckets],
         )
 
 
@@ -599,8 +1943,47 @@ class AccountInfo(Command):
             's3ApiUrl': account_info.get_s3_api_url(),
             'recommendedPartSize': account_info.get_recommended_part_size(),
             'absoluteMinimumPartSize': account_info.get_absolute_minimum_part_size(),
+            'applicationKeyId': account_info.get_application_key_id(),
+            'applicationKeyExpirationTimestamp': self._get_key_expiration_timestamp(
+                account_info.get_application_key_id()
+            ),
         }
 
+    def _get_key_expiration_timestamp(self, application_key_id):
+        # the key may not be allowed to list keys, its expiration is unknown then
+        try:
+            for key in self.api.list_keys(application_key_id):
+                if key.id_ == application_key_id:
+                    return key.expiration_timestamp_millis
+                break
+        except B2Error:
+            pass
+        return None
+
+
+@B2Provider.register_subcommand
+class TestFixtures(Command):
+    # Prepares the B2 state that the acceptance tests need, not used by the provider itself
+
+    def start_large_file(self, *, bucket_name, file_name, part_count, part_size, **kwargs):
+        bucket = self.api.get_bucket_by_name(bucket_name)
+        large_file = self.api.session.start_large_file(
+            bucket.id_, file_name, 'application/octet-stream', {}
+        )
+        part = b'x' * part_size
+        part_sha1 = hashlib.sha1(part).hexdigest()
+        for part_number in range(1, part_count + 1):
+            self.api.session.upload_part(
+                large_file['fileId'], part_number, part_size, part_sha1, io.BytesIO(part)
+            )
+        return {}
+
+    def delete_file_versions(self, *, bucket_name, **kwargs):
+        bucket = self.api.get_bucket_by_name(bucket_name)
+        for file_version, _ in bucket.ls(latest_only=False, recursive=True):
+            self.api.delete_file_version(file_version.id_, file_version.file_name)
+        return {}
+
 
 class ProviderTool:
     def __init__(self) -> None: